@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/proebsting/goaldi/ir"
 	g "github.com/proebsting/goaldi/runtime"
+	"strings"
 )
 
 // iLiteral replaces Ir_NilLit, Ir_IntLit, Ir_RealLit, Ir_StrLit
@@ -24,8 +25,11 @@ func coexecute(f *pr_frame, label string) (g.Value, *g.Closure) {
 func execute(f *pr_frame, label string) (rv g.Value, rc *g.Closure) {
 
 	// set up error catcher to call user recovery procedure
+	// and run any cleanup procedures registered by "defer"
 	defer func() {
+		f.ended = true
 		if p := recover(); p != nil {
+			defer f.cleanup()   // after handling the exception
 			if f.onerr != nil { // if user called recover()
 				// find true panic value hiding under traceback info
 				arglist := []g.Value{g.Cause(p)}
@@ -52,8 +56,16 @@ func execute(f *pr_frame, label string) (rv g.Value, rc *g.Closure) {
 		defer func() {
 			if p := recover(); p != nil {
 				// add traceback information and re-throw exception
-				panic(g.Catch(p,
-					[]g.Value{f.offv}, f.coord, f.info.name, f.args))
+				cf := g.Catch(p, []g.Value{f.offv}, f.coord, f.info.name, f.args)
+				if g.WantPostMortem(f.env) {
+					cf.Keep(f.env, f.snapshot())
+				}
+				p = cf
+				if f.ended {
+					// resumed generator: outer catcher is gone; clean up here
+					f.cleanup()
+				}
+				panic(p)
 			}
 		}()
 
@@ -86,10 +98,12 @@ func execute(f *pr_frame, label string) (rv g.Value, rc *g.Closure) {
 				case ir.Ir_NoOp:
 					// nothing to do
 				case ir.Ir_Fail:
+					f.cleanup()
 					return nil, nil
 				case ir.Ir_Succeed:
 					v := g.Deref(f.temps[i.Expr].(g.Value))
 					if i.ResumeLabel == "" {
+						f.cleanup()
 						return v, nil
 					} else {
 						label = i.ResumeLabel
@@ -105,6 +119,12 @@ func execute(f *pr_frame, label string) (rv g.Value, rc *g.Closure) {
 					if i.Lhs != 0 {
 						f.temps[i.Lhs] = f.onerr
 					}
+				case ir.Ir_Defer:
+					f.offv = g.Deref(f.temps[i.Fn])
+					f.defers = append(f.defers, f.offv.(g.ICall))
+					if i.Lhs != 0 {
+						f.temps[i.Lhs] = f.offv
+					}
 				case ir.Ir_Create:
 					fnew := newframe(f)
 					fnew.cxout = g.NewChannel(0)
@@ -124,11 +144,13 @@ func execute(f *pr_frame, label string) (rv g.Value, rc *g.Closure) {
 				case ir.Ir_CoRet:
 					f.coord = i.Coord
 					if g.CoSend(f.cxout, f.temps[i.Value]) == nil {
+						f.cleanup()
 						return nil, nil // kill self: channel was closed
 					}
 					label = i.ResumeLabel
 					continue NextChunk
 				case ir.Ir_CoFail:
+					f.cleanup()
 					close(f.cxout)
 					return nil, nil // i.e. die
 				case ir.Ir_Key: // dynamic variable reference
@@ -145,11 +167,11 @@ func execute(f *pr_frame, label string) (rv g.Value, rc *g.Closure) {
 					goto Dispatch
 				case ir.Ir_IntLit:
 					n, _ := g.ParseNumber(i.Val)
-					ilist[j] = iLiteral{i.Lhs, g.NewNumber(n)}
+					ilist[j] = iLiteral{i.Lhs, n}
 					goto Dispatch
 				case ir.Ir_RealLit:
 					n, _ := g.ParseNumber(i.Val)
-					ilist[j] = iLiteral{i.Lhs, g.NewNumber(n)}
+					ilist[j] = iLiteral{i.Lhs, n}
 					goto Dispatch
 				case ir.Ir_StrLit:
 					ilist[j] = iLiteral{i.Lhs, g.NewString(i.Val)}
@@ -311,6 +333,59 @@ func execute(f *pr_frame, label string) (rv g.Value, rc *g.Closure) {
 	return self.Resume()
 }
 
+// cleanup -- call deferred cleanup procedures, most recent first.
+// Each procedure is removed before it is called, and the remaining ones
+// still run if it throws an exception.
+func (f *pr_frame) cleanup() {
+	n := len(f.defers)
+	if n == 0 {
+		return
+	}
+	p := f.defers[n-1]
+	f.defers = f.defers[:n-1]
+	defer f.cleanup()
+	p.Call(f.env, []g.Value{}, []string{})
+}
+
+// snapshot -- list the variables of a frame for a post-mortem dump:
+// parameters, locals, statics, and dynamic constants declared here.
+// Locals of scopes not yet entered or already exited are omitted.
+func (f *pr_frame) snapshot() []g.FrameVar {
+	var vars []g.FrameVar
+	add := func(kind string, list []string) {
+		for _, name := range list {
+			if v := g.Deref(f.vars[name]); v != nil {
+				vars = append(vars, g.FrameVar{Kind: kind, Name: unadorned(name), Value: v})
+			}
+		}
+	}
+	add("param", f.info.params)
+	add("local", f.info.locals)
+	add("static", f.info.ir.StaticList)
+	seen := make(map[*g.Env]bool)
+	seen[f.env] = true
+	for k := range g.SortedKeys(f.vars) {
+		if e, ok := f.vars[k].(*g.Env); ok && !seen[e] {
+			seen[e] = true
+			for name := range g.SortedKeys(e.VarMap) {
+				if v := g.Deref(e.VarMap[name]); v != nil {
+					vars = append(vars, g.FrameVar{Kind: "dynamic", Name: "%" + name,
+						Value: v})
+				}
+			}
+		}
+	}
+	return vars
+}
+
+// unadorned -- strip the scope suffix from a variable name
+func unadorned(s string) string {
+	if i := strings.Index(s, ":"); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
 // irSelect -- execute select statement, returning label of chosen case body
 func irSelect(f *pr_frame, irs ir.Ir_Select) string {
 
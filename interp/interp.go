@@ -9,15 +9,17 @@ import (
 
 // procedure frame
 type pr_frame struct {
-	env   *g.Env                 // dynamic execution environment
-	info  *pr_Info               // static procedure information
-	args  []g.Value              // arglist as called
-	vars  map[string]interface{} // variables and scopes
-	temps []interface{}          // temporaries
-	coord string                 // last known source location
-	offv  g.Value                // offending value for traceback
-	cxout g.VChannel             // co-expression output pipe
-	onerr *g.VProcedure          // recovery procedure
+	env    *g.Env                 // dynamic execution environment
+	info   *pr_Info               // static procedure information
+	args   []g.Value              // arglist as called
+	vars   map[string]interface{} // variables and scopes
+	temps  []interface{}          // temporaries
+	coord  string                 // last known source location
+	offv   g.Value                // offending value for traceback
+	cxout  g.VChannel             // co-expression output pipe
+	onerr  *g.VProcedure          // recovery procedure
+	defers []g.ICall              // cleanup procedures, in order registered
+	ended  bool                   // initial execute() call has returned
 }
 
 // newframe(f) -- duplicate a procedure frame for "create e"
@@ -25,6 +27,8 @@ func newframe(f *pr_frame) *pr_frame {
 	fnew := &pr_frame{} // allocate new frame
 	*fnew = *f          // duplicate values
 	fnew.onerr = nil    // don't copy recovery procedure
+	fnew.defers = nil   // or cleanup procedures
+	fnew.ended = false
 	fnew.temps = make([]interface{}, len(f.temps))
 	fnew.vars = make(map[string]interface{})
 	for k, v := range f.vars {
@@ -149,7 +149,10 @@ func registerRecord(re *RecordEntry) {
 			var ext *g.VCtor
 			if re.ExtendsRec != "" {
 				pt := RecordTable[re.ExtendsRec]
-				if pt == nil {
+				pc, _ := g.StdLib[re.ExtendsRec].(*g.VCtor)
+				if pt == nil && pc != nil {
+					ext = pc // standard record type such as "exception"
+				} else if pt == nil {
 					fatal("Parent type not found: record " +
 						re.Name + " extends " + re.ExtendsRec)
 				} else if pt.ctor == regMark {
@@ -98,6 +98,11 @@ func main() {
 		g.EnvInit("gostack", g.ONE)
 	}
 
+	// set environment variable if to write post-mortem dump
+	if opt_pmdump {
+		g.EnvInit("postmortem", g.NewString("POSTMORTEM"))
+	}
+
 	// make a list for dependency-based global initialization
 	dlist := &g.DependencyList{}
 	// put procedures at the front of the list for proper dependency checking
@@ -133,6 +138,12 @@ func main() {
 	if gv, ok := gmain.(g.IVariable); ok {
 		gmain = gv.Deref()
 	}
+	// identify the program by the source file of main()
+	for _, pr := range ProcTable {
+		if pr.name == "main" && pr.space == PubSpace {
+			g.SourceName = strings.TrimRight(pr.ir.Coord, "0123456789:")
+		}
+	}
 
 	// run the sequence of initialization procedures
 	dlist.RunAll()                // global initializers as reordered
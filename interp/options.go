@@ -19,6 +19,7 @@ var opt_timings bool // -t: show CPU timings
 var opt_adump bool   // -A: dump assembly-style IR code
 var opt_debug bool   // -D: set debug flag (dump Go stack on panic)
 var opt_init bool    // -I: trace initialization ordering
+var opt_pmdump bool  // -M: write ./POSTMORTEM dump on fatal error
 var opt_envmt bool   // -E: show initial environment before loading
 var opt_profile bool // -P: produce CPU profile on ./PROFILE
 var opt_trace bool   // -T: trace IR instruction execution
@@ -47,6 +48,7 @@ func options() (files []string, args []string) {
 	flag.BoolVar(&opt_adump, "A", false, "dump assembly-style IR code")
 	flag.BoolVar(&opt_debug, "D", false, "dump Go stack on panic")
 	flag.BoolVar(&opt_init, "I", false, "trace initialization ordering")
+	flag.BoolVar(&opt_pmdump, "M", false, "write ./POSTMORTEM on fatal error")
 	flag.BoolVar(&opt_envmt, "E", false, "show initial environment")
 	flag.BoolVar(&opt_profile, "P", false, "produce ./PROFILE file (Linux)")
 	flag.BoolVar(&opt_trace, "T", false, "trace IR instruction execution")
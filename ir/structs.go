@@ -15,6 +15,7 @@ var irlist = [...]interface{}{
 	&Ir_chunk{},
 	&Ir_NoOp{}, // not normally seen, but allowed as a comment
 	&Ir_Catch{},
+	&Ir_Defer{},
 	&Ir_EnterScope{},
 	&Ir_ExitScope{},
 	&Ir_Var{},
@@ -112,6 +113,12 @@ type Ir_Catch struct {
 	Fn    int
 }
 
+type Ir_Defer struct {
+	Coord string
+	Lhs   int
+	Fn    int
+}
+
 type Ir_EnterScope struct {
 	Coord       string
 	NameList    []string
@@ -64,13 +64,13 @@ func Return(v Value) (Value, *Closure) {
 // The pnames value may be nil to indicate no param names are known.
 func ArgNames(p *VProcedure, args []Value, names []string) []Value {
 	if p.Pnames != nil && len(args) > len(*p.Pnames) && !p.Variadic {
-		panic(NewExn("Too many arguments", p))
+		panic(NewErr(ErrTooMany, p))
 	}
 	if len(names) == 0 {
 		return args
 	}
 	if p.Pnames == nil {
-		panic(NewExn("Named arguments not allowed", p))
+		panic(NewErr(ErrNamedArgs, p))
 	}
 
 	// make a list of target indexes for storing the named arguments seen
@@ -93,7 +93,7 @@ func ArgNames(p *VProcedure, args []Value, names []string) []Value {
 	// copy in the named arguments
 	for i, j := range locs {
 		if newargs[j] != nil {
-			panic(NewExn("Duplicate argument", names[i]))
+			panic(NewErr(ErrDupArg, names[i]))
 		}
 		newargs[j] = args[nbase+i]
 	}
@@ -125,5 +125,5 @@ func argIndex(name string, pnames *[]string) int {
 			return i
 		}
 	}
-	panic(NewExn("No parameter matches name", name))
+	panic(NewErr(ErrNoParam, name))
 }
@@ -0,0 +1,72 @@
+//  collate_test.go -- test string collation
+
+package runtime
+
+import (
+	"testing"
+)
+
+// testOrder checks that each string sorts strictly before the next
+func testOrder(t *testing.T, C *VCollator, list ...string) {
+	for i := 1; i < len(list); i++ {
+		a, b := NewString(list[i-1]), NewString(list[i])
+		if C.compare(a, b) >= 0 || C.compare(b, a) <= 0 {
+			t.Errorf("%s: %q should precede %q", C, list[i-1], list[i])
+		}
+	}
+}
+
+// testSame checks that two strings sort equally
+func testSame(t *testing.T, C *VCollator, a string, b string) {
+	if C.compare(NewString(a), NewString(b)) != 0 {
+		t.Errorf("%s/%d: %q should equal %q", C, C.strength, a, b)
+	}
+}
+
+func TestCollate(t *testing.T) {
+	root := NewCollator("", 3)
+	testOrder(t, root, "a", "A", "á", "Á", "ä", "b")
+	testOrder(t, root, "cote", "coté", "côte", "côté")
+	testOrder(t, root, "äpple", "apples", "Zebra", "zebras")
+	testOrder(t, root, "ña", "nb", "o")
+	testOrder(t, root, "1", "10", "9", "a")
+	testOrder(t, root, "ca", "ĉa", "ch", "ci")
+	testOrder(t, root, "z", "α", "я", "一", "丁", "\U00020000")
+	testSame(t, root, "é", "é")
+	testSame(t, root, "가", "가")
+
+	sv := NewCollator("sv_SE.UTF-8", 3)
+	testOrder(t, sv, "z", "å", "Å", "ä", "Ä", "ö", "Ö")
+	testOrder(t, sv, "zz", "ạ̊", "ä")
+	testOrder(t, sv, "ü", "å")
+	da := NewCollator("da", 3)
+	testOrder(t, da, "z", "æ", "ø", "å")
+	es := NewCollator("es-ES", 3)
+	testOrder(t, es, "nz", "ña", "Ña", "o")
+
+	testSame(t, NewCollator("de", 1), "Resume", "résumé")
+	testSame(t, NewCollator("de", 2), "resume", "RESUME")
+	testOrder(t, NewCollator("de", 2), "resume", "résumé")
+	testSame(t, NewCollator("de", 3), "resume", "resume")
+}
+
+// TestCollatorLocale checks the language codes reported by collators
+func TestCollatorLocale(t *testing.T) {
+	for loc, lang := range map[string]string{"": "root", "C": "root",
+		"und": "root", "fr_FR": "root", "xx": "root", "sv_SE.UTF-8": "sv",
+		"es-ES": "es", "NB": "nb"} {
+		if C := NewCollator(loc, 3); C.locale != lang {
+			t.Errorf("collator for %q has locale %q, expected %q",
+				loc, C.locale, lang)
+		}
+	}
+}
+
+// TestUcadataVersion checks that the collation table of ucadata.go
+// matches the normalization tables of unidata.go that it relies on
+func TestUcadataVersion(t *testing.T) {
+	if ucadataVersion != unidataVersion {
+		t.Errorf("ucadata.go DUCET %s does not match unidata.go Unicode %s",
+			ucadataVersion, unidataVersion)
+	}
+}
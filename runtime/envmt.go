@@ -44,7 +44,7 @@ func (e *Env) Lookup(s string, rval bool) Value {
 			return d // return value -- cannot be used as variable
 		}
 	}
-	panic(NewExn("Undefined dynamic variable", "%"+s))
+	panic(NewErr(ErrUndefDyn, "%"+s))
 }
 
 // ThreadID production
@@ -0,0 +1,226 @@
+//  errcodes.go -- the catalogue of numbered run-time errors
+//
+//  Every exception raised by the runtime system carries an error code
+//  that identifies it independently of its message text.
+//  Codes are grouped by subject, roughly following the Icon tradition:
+//	  1-99	general and variables
+//	100-199	numbers
+//	200-299	strings
+//	300-399	files and channels
+//	400-499	procedure calls
+//	500-599	structures
+//	600-699	records, fields, and methods
+//	700-799	data interchange: JSON, images, and serialization
+//	900-999	internal problems
+
+package runtime
+
+import (
+	"fmt"
+)
+
+// ErrCode is a number identifying a particular kind of run-time error
+type ErrCode int
+
+// Run-time error codes
+const (
+	ErrType     ErrCode = 1  // wrong type (general)
+	ErrGo       ErrCode = 2  // error returned by a Go function
+	ErrGoPanic  ErrCode = 3  // Go runtime panic
+	ErrUndefDyn ErrCode = 10 // undefined dynamic variable
+	ErrVariable ErrCode = 11 // variable expected
+
+	ErrNumber    ErrCode = 101 // number expected
+	ErrConvert   ErrCode = 102 // cannot convert string to number
+	ErrNegRandom ErrCode = 103 // ?n with n < 0
+	ErrIncrement ErrCode = 104 // zero increment in "to by"
+	ErrNonPos    ErrCode = 105 // nonpositive argument
+	ErrNotInt    ErrCode = 106 // infinite or NaN where integer needed
+	ErrDivide    ErrCode = 107 // exact division by zero
+	ErrInfinite  ErrCode = 108 // infinite or NaN where exact value needed
+	ErrNotReal   ErrCode = 109 // complex number where real needed
+
+	ErrString   ErrCode = 201 // string expected
+	ErrNotVar   ErrCode = 202 // substring of non-variable
+	ErrCharCode ErrCode = 203 // character code out of range
+	ErrCharLen  ErrCode = 204 // string length not 1
+	ErrPadding  ErrCode = 205 // empty padding string
+	ErrMapLen   ErrCode = 206 // map() argument lengths differ
+	ErrShrunk   ErrCode = 207 // string shrunk during assignment
+	ErrBytes    ErrCode = 208 // bytes expected
+	ErrByteVal  ErrCode = 209 // byte value out of range
+	ErrEncoding ErrCode = 210 // unrecognized character encoding
+	ErrNormForm ErrCode = 211 // unrecognized normalization form
+	ErrStrength ErrCode = 212 // collation strength out of range
+
+	ErrIOGo      ErrCode = 300 // input or output error reported by Go
+	ErrFlag      ErrCode = 301 // unrecognized file flag
+	ErrNotReader ErrCode = 302 // file not open for reading
+	ErrNotWriter ErrCode = 303 // file not open for writing
+	ErrNotSeek   ErrCode = 304 // file not seekable
+	ErrNotOpen   ErrCode = 305 // file not open
+	ErrChannel   ErrCode = 341 // channel expected
+
+	ErrTooMany   ErrCode = 401 // too many arguments
+	ErrNamedArgs ErrCode = 402 // named arguments not allowed
+	ErrDupArg    ErrCode = 403 // duplicate argument
+	ErrNoParam   ErrCode = 404 // no parameter matches name
+	ErrNotFunc   ErrCode = 405 // Go function expected
+	ErrArgConv   ErrCode = 406 // cannot convert argument for Go function
+
+	ErrList     ErrCode = 501 // list expected
+	ErrSet      ErrCode = 502 // set expected
+	ErrIndexing ErrCode = 503 // value cannot be indexed
+	ErrFieldIdx ErrCode = 504 // nonpositive field index
+	ErrIndex    ErrCode = 505 // index out of range (Go)
+	ErrOrdered  ErrCode = 506 // ordered table expected
+	ErrNoCrit   ErrCode = 507 // empty list of sort criteria
+
+	ErrNoField  ErrCode = 601 // field not found
+	ErrNoMethod ErrCode = 602 // unrecognized field or method
+	ErrDupField ErrCode = 603 // duplicate field name
+	ErrIdent    ErrCode = 604 // not an identifier
+	ErrTuple    ErrCode = 605 // unnamed tuple arguments
+
+	ErrJSONEnc  ErrCode = 701 // value cannot be encoded as JSON
+	ErrJSONDec  ErrCode = 702 // invalid JSON
+	ErrParse    ErrCode = 703 // invalid value image
+	ErrSerial   ErrCode = 704 // value cannot be serialized
+	ErrDeserial ErrCode = 705 // invalid serialized data
+
+	ErrMalfunction ErrCode = 999 // internal Goaldi malfunction
+)
+
+// errText gives the standard message for each error code
+var errText = map[ErrCode]string{
+	ErrType:     "Wrong type",
+	ErrGo:       "Go error",
+	ErrGoPanic:  "Go runtime error",
+	ErrUndefDyn: "Undefined dynamic variable",
+	ErrVariable: "Variable expected",
+
+	ErrNumber:    "Number expected",
+	ErrConvert:   "Cannot convert to number",
+	ErrNegRandom: "?n < 0",
+	ErrIncrement: "ToBy: bad increment",
+	ErrNonPos:    "Nonpositive argument",
+	ErrNotInt:    "Finite integer expected",
+	ErrDivide:    "Division by zero",
+	ErrInfinite:  "Finite number expected",
+	ErrNotReal:   "Real number expected",
+
+	ErrString:   "String expected",
+	ErrNotVar:   "Not a variable",
+	ErrCharCode: "Character code out of range",
+	ErrCharLen:  "String length not 1",
+	ErrPadding:  "Empty padding string",
+	ErrMapLen:   "Map: *into > *from",
+	ErrShrunk:   "String shrunk before assignment complete",
+	ErrBytes:    "Bytes expected",
+	ErrByteVal:  "Byte value out of range",
+	ErrEncoding: "Unrecognized encoding",
+	ErrNormForm: "Unrecognized normalization form",
+	ErrStrength: "Collation strength out of range",
+
+	ErrIOGo:      "I/O error",
+	ErrFlag:      "Unrecognized flag",
+	ErrNotReader: "Not open for reading",
+	ErrNotWriter: "Not open for writing",
+	ErrNotSeek:   "Not seekable",
+	ErrNotOpen:   "File not open",
+	ErrChannel:   "Not a channel",
+
+	ErrTooMany:   "Too many arguments",
+	ErrNamedArgs: "Named arguments not allowed",
+	ErrDupArg:    "Duplicate argument",
+	ErrNoParam:   "No parameter matches name",
+	ErrNotFunc:   "Not a func",
+	ErrArgConv:   "Cannot convert argument",
+
+	ErrList:     "Not a list",
+	ErrSet:      "Not a set",
+	ErrIndexing: "Wrong type for indexing",
+	ErrFieldIdx: "Nonpositive field index",
+	ErrIndex:    "Index out of range",
+	ErrOrdered:  "Not an ordered table",
+	ErrNoCrit:   "Empty list of sort criteria",
+
+	ErrNoField:  "Field not found",
+	ErrNoMethod: "Unrecognized field or method",
+	ErrDupField: "Duplicate field name",
+	ErrIdent:    "Not an identifier",
+	ErrTuple:    "Unnamed tuple arguments not allowed",
+
+	ErrJSONEnc:  "Cannot encode as JSON",
+	ErrJSONDec:  "Invalid JSON",
+	ErrParse:    "Cannot parse value",
+	ErrSerial:   "Cannot serialize",
+	ErrDeserial: "Invalid serialized data",
+
+	ErrMalfunction: "Goaldi runtime malfunction",
+}
+
+// errKind gives the exception kind of each error code;
+// codes not listed are of the basic kind "exception".
+var errKind = map[ErrCode]*VCtor{
+	ErrType:      TypeErrorKind,
+	ErrVariable:  TypeErrorKind,
+	ErrNumber:    TypeErrorKind,
+	ErrConvert:   TypeErrorKind,
+	ErrNotReal:   TypeErrorKind,
+	ErrString:    TypeErrorKind,
+	ErrNotVar:    TypeErrorKind,
+	ErrCharCode:  IndexErrorKind,
+	ErrBytes:     TypeErrorKind,
+	ErrByteVal:   IndexErrorKind,
+	ErrStrength:  IndexErrorKind,
+	ErrIOGo:      IOErrorKind,
+	ErrNotReader: IOErrorKind,
+	ErrNotWriter: IOErrorKind,
+	ErrNotSeek:   IOErrorKind,
+	ErrNotOpen:   IOErrorKind,
+	ErrChannel:   TypeErrorKind,
+	ErrNotFunc:   TypeErrorKind,
+	ErrArgConv:   TypeErrorKind,
+	ErrList:      TypeErrorKind,
+	ErrSet:       TypeErrorKind,
+	ErrOrdered:   TypeErrorKind,
+	ErrIndexing:  TypeErrorKind,
+	ErrFieldIdx:  IndexErrorKind,
+	ErrIndex:     IndexErrorKind,
+}
+
+func init() {
+	DefLib(ErrorText, "errortext", "n", "return message for error code")
+}
+
+// ErrCode.String() returns the standard message for an error code
+func (c ErrCode) String() string {
+	if s, ok := errText[c]; ok {
+		return s
+	}
+	return fmt.Sprintf("Fatal error %d", int(c))
+}
+
+// NewErr(c,v,...) creates an Exception for error code c
+// with zero or more offending values
+func NewErr(c ErrCode, v ...Value) *Exception {
+	return &Exception{Msg: c.String(), Offv: v, Code: c}
+}
+
+// NewErrDetail(c,s,v,...) creates an Exception for error code c,
+// appending detail string s to the standard message
+func NewErrDetail(c ErrCode, s string, v ...Value) *Exception {
+	return &Exception{Msg: c.String() + ": " + s, Offv: v, Code: c}
+}
+
+// errortext(n) returns the standard message associated with
+// run-time error code n.  It fails if n is not a known error code.
+func ErrorText(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("errortext", args)
+	c := ErrCode(IntVal(ProcArg(args, 0, NilValue)))
+	if s, ok := errText[c]; ok {
+		return Return(NewString(s))
+	}
+	return Fail()
+}
@@ -3,6 +3,7 @@
 package runtime
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -11,10 +12,34 @@ import (
 	"strings"
 )
 
-// Exception records a Goaldi panic value
+// Exception records a Goaldi panic value.
+// An Exception is also a first-class Goaldi value whose type is its kind,
+// a record constructor descended from the standard "exception" type.
 type Exception struct {
-	Msg  string  // explanatory message
-	Offv []Value // offending values (Goaldi or Go values)
+	Msg   string   // explanatory message
+	Offv  []Value  // offending values (Goaldi or Go values)
+	Code  ErrCode  // error code, or 0 if none
+	Kind  *VCtor   // exception kind (nil implies kind from code)
+	Cause Value    // underlying Go error or panic value, if any
+	Trace []string // traceback, innermost call first, once caught
+}
+
+// Standard exception kinds.
+// User code can declare additional kinds as records extending "exception".
+var ExceptionKind = NewCtor("exception", nil,
+	[]string{"msg", "offending", "traceback", "cause", "code"})
+var TypeErrorKind = NewCtor("typeerror", ExceptionKind, nil)
+var IndexErrorKind = NewCtor("indexerror", ExceptionKind, nil)
+var IOErrorKind = NewCtor("ioerror", ExceptionKind, nil)
+
+var _ ICore = &Exception{} // validate implementation
+
+func init() {
+	StdLib["exception"] = ExceptionKind
+	StdLib["typeerror"] = TypeErrorKind
+	StdLib["indexerror"] = IndexErrorKind
+	StdLib["ioerror"] = IOErrorKind
+	DefLib(Rethrow, "rethrow", "e,k[]", "rethrow exception unless of given kinds")
 }
 
 // Exception.Error(), by its existence, makes an Exception a Go "error"
@@ -24,7 +49,11 @@ func (e *Exception) Error() string {
 
 // Exception.String() returns a string form of a Exception
 func (e *Exception) String() string {
-	s := fmt.Sprintf("Exception(%#v", e.Msg)
+	s := "Exception"
+	if k := e.kind(); k != ExceptionKind {
+		s = k.TypeName
+	}
+	s = fmt.Sprintf("%s(%#v", s, e.Msg)
 	for _, v := range e.Offv {
 		s = fmt.Sprintf("%s,%#v", s, v)
 	}
@@ -36,9 +65,114 @@ func (e *Exception) GoString() string {
 	return e.String()
 }
 
+// Exception.Type() returns the exception kind
+func (e *Exception) Type() IRank {
+	return e.kind()
+}
+
+// Exception.kind() returns the kind, defaulting to that of the error code
+func (e *Exception) kind() *VCtor {
+	if e.Kind != nil {
+		return e.Kind
+	} else if k := errKind[e.Code]; k != nil {
+		return k
+	} else {
+		return ExceptionKind
+	}
+}
+
+// Exception.Copy() returns a distinct copy of itself
+func (e *Exception) Copy() Value {
+	x := *e
+	return &x
+}
+
+// Exception.Before() orders exceptions by kind name and then by message
+func (a *Exception) Before(x Value, i int) bool {
+	if b, ok := x.(*Exception); ok {
+		if a.kind() != b.kind() {
+			return a.kind().TypeName < b.kind().TypeName
+		}
+		return a.Msg < b.Msg
+	}
+	return a.kind().TypeName < x.(ICore).Type().(*VCtor).TypeName
+}
+
+// Exception.Import() returns itself
+func (e *Exception) Import() Value {
+	return e
+}
+
+// Exception.Export() returns itself
+func (e *Exception) Export() interface{} {
+	return e
+}
+
+// Exception.Field() implements e.msg, e.offending, e.traceback, e.cause,
+// and e.code (the fields of an "exception" record)
+// as well as any methods of its kind.
+func (e *Exception) Field(f string) Value {
+	switch f {
+	case "msg":
+		return NewString(e.Msg)
+	case "offending":
+		return InitList(append([]Value{}, e.Offv...))
+	case "traceback":
+		return traceList(e.Trace)
+	case "cause":
+		if e.Cause == nil {
+			return NilValue
+		}
+		return Import(e.Cause)
+	case "code":
+		if e.Code == 0 {
+			return NilValue
+		}
+		return NewNumber(float64(e.Code))
+	default:
+		return GetMethod(e.kind().Methods, e, f)
+	}
+}
+
 // NewExn(s,v,...) creates and returns an Exception struct
 func NewExn(s string, v ...Value) *Exception {
-	return &Exception{s, v}
+	return &Exception{Msg: s, Offv: v}
+}
+
+// NewKindExn(k,s,v,...) creates an Exception of a particular kind
+func NewKindExn(k *VCtor, s string, v ...Value) *Exception {
+	return &Exception{Msg: s, Offv: v, Kind: k}
+}
+
+// IsException(x) reports whether x is an exception:
+// either an Exception struct or a record extending "exception".
+func IsException(x Value) bool {
+	if _, ok := x.(*Exception); ok {
+		return true
+	}
+	if r, ok := x.(*VRecord); ok {
+		return IsInstance(r, ExceptionKind)
+	}
+	return false
+}
+
+// rethrow(e, k...) returns e if it is an instance of any of the
+// exception kinds k; otherwise it throws e again.
+// It is intended for use in a catch procedure that handles some
+// kinds of exceptions and passes along any others.
+func Rethrow(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("rethrow", args)
+	e := ProcArg(args, 0, NilValue)
+	for i := 1; i < len(args); i++ {
+		k, ok := args[i].(IRank)
+		if !ok {
+			panic(NewErr(ErrType, args[i]))
+		}
+		if IsInstance(e, k) {
+			return Return(e)
+		}
+	}
+	panic(e)
 }
 
 // A Malfunction indicates an internal Goaldi problem (vs. a user error)
@@ -61,6 +195,8 @@ type CallFrame struct {
 	coord string      // source coords (file:line:colm)
 	pname string      // procedure name
 	args  []Value     // procedure arguments
+	env   *Env        // environment, if kept for post-mortem
+	vars  []FrameVar  // variables, if kept for post-mortem
 }
 
 // Traceback is called as a deferred function to catch and annotate a panic
@@ -76,19 +212,112 @@ func Catch(p interface{}, ev []Value, coord string,
 	if te, ok := p.(*runtime.TypeAssertionError); ok {
 		p = (*TypeError)(te)
 	}
-	return &CallFrame{p, ev, coord, procname, arglist}
+	return &CallFrame{cause: p, offv: ev, coord: coord,
+		pname: procname, args: arglist}
 }
 
 // Cause(x) returns the original panic underlying a chain of CallFrame structs.
 // This is the value passed to an exception catcher.
+// Go errors and type assertion failures are converted to Exceptions,
+// and an exception not previously caught acquires a traceback.
 func Cause(x interface{}) interface{} {
+	var trace []string
+	var offv []Value
 	for {
 		if f, ok := x.(*CallFrame); ok {
+			trace = append([]string{f.String()}, trace...)
+			offv = f.offv
 			x = f.cause
 		} else {
-			return x
+			return asException(x, trace, offv)
+		}
+	}
+}
+
+// asException(x, trace, offv) converts a panic value to a Goaldi exception,
+// setting the traceback if not already set.
+// Values thrown explicitly by Goaldi code, other than records, are unchanged.
+func asException(x interface{}, trace []string, offv []Value) interface{} {
+	switch e := x.(type) {
+	case *Exception:
+		if e.Trace == nil {
+			e.Trace = trace
 		}
+		return e
+	case *VRecord:
+		if IsInstance(e, ExceptionKind) {
+			i := ExceptionKind.Fmap["traceback"] - 1
+			if e.Data[i] == NilValue {
+				e.Data[i] = traceList(trace)
+			}
+		}
+		return e
+	case *runtime.TypeAssertionError:
+		return asException((*TypeError)(e), trace, offv)
+	case *TypeError:
+		v := make([]Value, 0, len(offv))
+		for _, o := range offv {
+			if o != nil {
+				v = append(v, o)
+			}
+		}
+		return &Exception{Msg: e.Cleanup(), Offv: v, Code: e.Code(),
+			Cause: e, Trace: trace}
+	case Malfunction:
+		return &Exception{Msg: e.String(), Code: ErrMalfunction,
+			Cause: e, Trace: trace}
+	case runtime.Error:
+		c := ErrGoPanic
+		if s := e.Error(); strings.Contains(s, "out of range") ||
+			strings.Contains(s, "bounds out of") {
+			c = ErrIndex
+		}
+		return &Exception{Msg: e.Error(), Code: c, Cause: e, Trace: trace}
+	case error:
+		c := ErrGo
+		if isIOError(e) {
+			c = ErrIOGo
+		}
+		return &Exception{Msg: e.Error(), Code: c, Cause: e, Trace: trace}
+	default:
+		return x
+	}
+}
+
+// isIOError(e) reports whether a Go error arises from input or output
+func isIOError(e error) bool {
+	var pe *os.PathError
+	var le *os.LinkError
+	var se *os.SyscallError
+	return errors.As(e, &pe) || errors.As(e, &le) || errors.As(e, &se) ||
+		errors.Is(e, io.EOF) || errors.Is(e, io.ErrUnexpectedEOF) ||
+		errors.Is(e, io.ErrClosedPipe) || errors.Is(e, os.ErrClosed)
+}
+
+// traceList(trace) makes a Goaldi list of traceback strings
+func traceList(trace []string) *VList {
+	v := make([]Value, len(trace))
+	for i, s := range trace {
+		v[i] = NewString(s)
 	}
+	return InitList(v)
+}
+
+// CallFrame.String() renders one traceback line
+func (x *CallFrame) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s(", x.pname)
+	for i, a := range x.args {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, "%#v", a)
+	}
+	b.WriteString(")")
+	if x.coord != "" {
+		fmt.Fprintf(&b, " at %s", x.coord)
+	}
+	return b.String()
 }
 
 // Catcher(env) prints a traceback after a panic.
@@ -96,6 +325,7 @@ func Cause(x interface{}) interface{} {
 func Catcher(env *Env) {
 	if x := recover(); x != nil {
 		Diagnose(os.Stderr, x)                       // write Goaldi stack trace
+		PostMortem(x)                                // write dump if requested
 		if env.Lookup("gostack", true) != NilValue { // if interpr set %gostack
 			fmt.Fprintf(os.Stderr, "Go stack:\n%s\n",
 				debug.Stack()) // write Go stack trace
@@ -116,25 +346,29 @@ func Diagnose(f io.Writer, v interface{}) bool {
 				fmt.Fprintf(f, "Offending value: %#v\n", v)
 			}
 		}
-		fmt.Fprintf(f, "Called by %s(", x.pname)
-		for i, a := range x.args {
-			if i > 0 {
-				fmt.Fprintf(f, ",")
-			}
-			fmt.Fprintf(f, "%#v", a)
-		}
-		if x.coord != "" {
-			fmt.Fprintf(f, ") at %s\n", x.coord)
-		} else {
-			fmt.Fprintf(f, ")\n")
-		}
+		fmt.Fprintf(f, "Called by %s\n", x)
 		return rv
 	case *Exception:
+		if x.Code != 0 {
+			fmt.Fprintf(f, "Error %d: ", x.Code)
+		}
 		fmt.Fprintln(f, x.Msg)
 		for _, v := range x.Offv {
 			fmt.Fprintf(f, "Offending value: %#v\n", v)
 		}
 		return true
+	case *VRecord:
+		if !IsInstance(x, ExceptionKind) {
+			fmt.Fprintf(f, "PANIC: %#v\n", x)
+			return false
+		}
+		fmt.Fprintln(f, x.Data[0])
+		if L, ok := x.Data[1].(*VList); ok {
+			for _, v := range L.Export().([]Value) {
+				fmt.Fprintf(f, "Offending value: %#v\n", v)
+			}
+		}
+		return true
 	case *TypeError:
 		fmt.Fprintln(f, x.Cleanup())
 		return true
@@ -160,6 +394,26 @@ func (e *TypeError) Error() string {
 	return `TypeError("` + e.Cleanup() + `")`
 }
 
+// Code() returns the error code corresponding to a TypeError
+func (e *TypeError) Code() ErrCode {
+	switch e.isnot() {
+	case "IVariable":
+		return ErrVariable
+	case "Numerable":
+		return ErrNumber
+	case "Stringable":
+		return ErrString
+	default:
+		return ErrType
+	}
+}
+
+// isnot() returns the name of the type or interface that was expected
+func (e *TypeError) isnot() string {
+	errstr := ((*runtime.TypeAssertionError)(e)).Error()
+	return extract(errstr, " not ")
+}
+
 // Cleanup() simplifies the underlying Go runtime.TypeAssertionError.
 // (This would be a lot easier if the error object fields weren't protected.)
 func (e *TypeError) Cleanup() string {
@@ -167,13 +421,9 @@ func (e *TypeError) Cleanup() string {
 	subj := extract(errstr, "conversion: ")
 	itis := extract(errstr, " is ")
 	isnot := extract(errstr, " not ")
-	switch isnot {
-	case "IVariable":
-		return "Variable expected"
-	case "Numerable":
-		return "Number expected"
-	case "Stringable":
-		return "String expected"
+	switch c := e.Code(); c {
+	case ErrVariable, ErrNumber, ErrString:
+		return c.String()
 	default:
 		if itis != "not" { // i.e. "e is t" not "e is not ..."
 			return fmt.Sprintf("%s is not %s", itis, isnot)
@@ -70,7 +70,7 @@ func GoChanPut(c Value, args ...Value) (Value, *Closure) {
 func GoChanSend(x Value, v Value) Value {
 	cv := reflect.ValueOf(x)
 	if cv.Kind() != reflect.Chan {
-		panic(NewExn("Not a channel", x))
+		panic(NewErr(ErrChannel, x))
 	}
 	cv.Send(reflect.ValueOf(Export(v)))
 	return v
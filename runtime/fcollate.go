@@ -0,0 +1,64 @@
+//  fcollate.go -- collator functions and methods
+
+package runtime
+
+// Declare methods
+var CollatorMethods = MethodTable([]*VProcedure{
+	DefMeth((*VCollator).Compare, "compare", "a,b", "compare strings"),
+	DefMeth((*VCollator).Key, "key", "s", "get sort key"),
+	DefMeth((*VCollator).Locale, "locale", "", "get language code"),
+	DefMeth((*VCollator).Strength, "strength", "", "get strength"),
+})
+
+// collate(locale, strength) creates a collator that orders strings by the
+// Unicode Collation Algorithm.  The language code is taken from locale,
+// so that "sv", "sv_SE", and "sv-SE.UTF-8" all select Swedish.
+// Danish, Finnish, Norwegian, Spanish, and Swedish are tailored to
+// their alphabets; other languages, and the default locale, use the
+// Unicode default ordering and are reported by C.locale() as "root".
+// With strength 1, only base letters are significant; with strength 2,
+// accents are also significant; with the default strength 3,
+// case and variant forms are significant as well.
+// A collator C is called as C(a,b) to compare a and b in the manner of
+// a << b, so C may be passed to L.sort() as the comparison procedure.
+func Collate(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("collate", args)
+	locale := ToString(ProcArg(args, 0, EMPTY)).ToUTF8()
+	strength := ProcArg(args, 1, NewNumber(3))
+	n := IntVal(strength)
+	if n < 1 || n > 3 {
+		panic(NewErr(ErrStrength, strength))
+	}
+	return Return(NewCollator(locale, n))
+}
+
+// C.compare(a, b) returns -1, 0, or 1 as string a sorts before,
+// equally with, or after string b under collator C.
+func (C *VCollator) Compare(args ...Value) (Value, *Closure) {
+	defer Traceback("C.compare", args)
+	a := ToString(ProcArg(args, 0, NilValue))
+	b := ToString(ProcArg(args, 1, NilValue))
+	return Return(NewNumber(float64(C.compare(a, b))))
+}
+
+// C.key(s) returns the sort key of string s under collator C as a bytes
+// value.  Keys sort in the same order as their strings, and are equal
+// if their strings sort equally, so L.sort(, C.key) sorts a list of
+// strings without recomputing the key of each at every comparison.
+func (C *VCollator) Key(args ...Value) (Value, *Closure) {
+	defer Traceback("C.key", args)
+	return Return(&VBytes{C.key(ToString(ProcArg(args, 0, NilValue))), nil})
+}
+
+// C.locale() returns the language code of collator C,
+// or "root" if C uses the Unicode default ordering.
+func (C *VCollator) Locale(args ...Value) (Value, *Closure) {
+	defer Traceback("C.locale", args)
+	return Return(NewString(C.locale))
+}
+
+// C.strength() returns the strength of collator C.
+func (C *VCollator) Strength(args ...Value) (Value, *Closure) {
+	defer Traceback("C.strength", args)
+	return Return(NewNumber(float64(C.strength)))
+}
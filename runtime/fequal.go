@@ -0,0 +1,336 @@
+//  fequal.go -- deep copying and structural equality
+//
+//  Structural equality extends identity (===) to compare the contents
+//  of lists, sets, tables, sorted maps, and records.  Two structures are equal if
+//  they have the same shape and equal contents, where a pair of
+//  structures already being compared is assumed equal; this makes
+//  comparison of cyclic structures terminate.
+//
+//  StructHash is consistent with StructEqual: equal values have equal
+//  hashes.  Structures are hashed only to a limited depth.
+
+package runtime
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// Declare procedures
+func init() {
+	DefLib(DeepCopy, "deepcopy", "x", "copy structure recursively")
+	DefLib(Equal, "equal", "x,y", "test structural equality")
+	DefLib(Hash, "hash", "x", "compute structural hash value")
+}
+
+// deepcopy(x) returns a copy of x in which every list, set, table,
+// sorted map, and record reachable from x is also copied.
+// Sharing among the copied structures, including cycles, is preserved.
+// Values of other types are not copied.
+func DeepCopy(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("deepcopy", args)
+	return Return(deepCopy(ProcArg(args, 0, NilValue), make(map[Value]Value)))
+}
+
+// deepCopy(x, done) copies x, using done to map structures already copied
+func deepCopy(x Value, done map[Value]Value) Value {
+	if c, ok := done[x]; ok {
+		return c
+	}
+	switch v := x.(type) {
+	case *VList:
+		c := InitList(make([]Value, len(v.data)))
+		done[x] = c
+		for i := range c.data {
+			c.data[i] = deepCopy(v.Elem(i), done)
+		}
+		return c
+	case *VSet:
+		c := v.empty()
+		done[x] = c
+		for k := range v.members {
+			c.add(deepCopy(Import(k), done))
+		}
+		return c
+	case *VTable:
+		c := v.empty()
+		done[x] = c
+		c.dfval = deepCopy(v.dfval, done)
+		for _, k := range v.keys() {
+			c.put(deepCopy(Import(k), done), deepCopy(v.data[k], done))
+		}
+		return c
+	case *VSortMap:
+		c := NewSortMap(NilValue)
+		done[x] = c
+		c.dfval = deepCopy(v.dfval, done)
+		v.root.walk(func(n *smNode) {
+			c.put(deepCopy(n.key, done), deepCopy(n.val, done))
+		})
+		return c
+	case *VRecord:
+		c := v.Ctor.New(nil)
+		done[x] = c
+		for i, e := range v.Data {
+			c.Data[i] = deepCopy(e, done)
+		}
+		return c
+	default:
+		return x
+	}
+}
+
+// equal(x, y) returns y if x and y are structurally equal, and fails
+// otherwise.  Values other than structures are equal if identical.
+// Lists are equal if they have the same size and equal elements.
+// Records are equal if they have the same type and equal fields.
+// Sets are equal if every element of each has an equal element in
+// the other; tables are compared similarly by key and value, and
+// must also have equal default values.
+// Sorted maps are equal if they have equal default values and
+// equal keys and values in the same order.
+// Cycles are handled correctly.
+func Equal(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("equal", args)
+	x := ProcArg(args, 0, NilValue)
+	y := ProcArg(args, 1, NilValue)
+	if StructEqual(x, y) {
+		return Return(y)
+	}
+	return Fail()
+}
+
+// hash(x) returns a nonnegative integer hash value for x that is
+// consistent with equal(): equal values have equal hash values.
+func Hash(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("hash", args)
+	h := StructHash(ProcArg(args, 0, NilValue))
+	return Return(NewNumber(float64(h & (1<<52 - 1))))
+}
+
+// StructEqual(x, y) reports whether x and y are structurally equal
+func StructEqual(x, y Value) bool {
+	return structEqual(x, y, make(map[[2]Value]bool))
+}
+
+// structEqual(x, y, active) compares x and y, assuming equality
+// of the pairs in the active set, which are being compared already
+func structEqual(x, y Value, active map[[2]Value]bool) bool {
+	if x == y {
+		return true
+	}
+	switch a := x.(type) {
+	case *VList, *VSet, *VTable, *VSortMap, *VRecord:
+		if reflect.TypeOf(x) != reflect.TypeOf(y) {
+			return false
+		}
+		pair := [2]Value{x, y}
+		if active[pair] {
+			return true
+		}
+		active[pair] = true
+		defer delete(active, pair)
+		switch a := a.(type) {
+		case *VList:
+			b := y.(*VList)
+			if len(a.data) != len(b.data) {
+				return false
+			}
+			for i := range a.data {
+				if !structEqual(a.Elem(i), b.Elem(i), active) {
+					return false
+				}
+			}
+			return true
+		case *VSet:
+			b := y.(*VSet)
+			return setCovers(a, b, active) && setCovers(b, a, active)
+		case *VTable:
+			b := y.(*VTable)
+			return structEqual(a.dfval, b.dfval, active) &&
+				tableCovers(a, b, active) && tableCovers(b, a, active)
+		case *VSortMap:
+			b := y.(*VSortMap)
+			if a.root.count() != b.root.count() ||
+				!structEqual(a.dfval, b.dfval, active) {
+				return false
+			}
+			l1, l2 := a.entries(), b.entries()
+			for i := range l1 {
+				if !structEqual(l1[i].key, l2[i].key, active) ||
+					!structEqual(l1[i].val, l2[i].val, active) {
+					return false
+				}
+			}
+			return true
+		case *VRecord:
+			b := y.(*VRecord)
+			if a.Ctor != b.Ctor {
+				return false
+			}
+			for i := range a.Data {
+				if !structEqual(a.Data[i], b.Data[i], active) {
+					return false
+				}
+			}
+			return true
+		}
+	case *VMethVal:
+		b, ok := y.(*VMethVal)
+		return ok && a.Proc == b.Proc && structEqual(a.Val, b.Val, active)
+	}
+	return Identical(x, y) != nil
+}
+
+// setCovers(a, b, active) reports whether every element of a
+// has an equal element in b
+func setCovers(a, b *VSet, active map[[2]Value]bool) bool {
+	for k := range a.members {
+		if b.members[k] {
+			continue // identical element, found directly
+		}
+		x := Import(k)
+		found := false
+		for j := range b.members {
+			if structEqual(x, Import(j), active) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// tableCovers(a, b, active) reports whether every entry of a
+// has an entry in b with equal key and value
+func tableCovers(a, b *VTable, active map[[2]Value]bool) bool {
+	for k, v := range a.data {
+		if w, ok := b.data[k]; ok && structEqual(v, w, active) {
+			continue // identical key, found directly
+		}
+		x := Import(k)
+		found := false
+		for j, w := range b.data {
+			if structEqual(x, Import(j), active) && structEqual(v, w, active) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// hashDepth is the depth to which nested structures are hashed
+const hashDepth = 4
+
+// StructHash(x) returns a hash value for x that is consistent with
+// StructEqual
+func StructHash(x Value) uint64 {
+	return structHash(x, hashDepth)
+}
+
+// structHash(x, d) hashes x, including nested structures to depth d
+func structHash(x Value, d int) uint64 {
+	h := fnv.New64a()
+	put := func(n uint64) {
+		var a [8]byte
+		binary.BigEndian.PutUint64(a[:], n)
+		h.Write(a[:])
+	}
+	fmt.Fprintf(h, "%T", x)
+	switch v := x.(type) {
+	case *VList:
+		put(uint64(len(v.data)))
+		if d > 0 {
+			for i := range v.data {
+				put(structHash(v.Elem(i), d-1))
+			}
+		}
+	case *VSet:
+		if d > 0 {
+			hs := make([]uint64, 0, len(v.members))
+			for k := range v.members {
+				hs = append(hs, structHash(Import(k), d-1))
+			}
+			putUnique(hs, put)
+		}
+	case *VTable:
+		if d > 0 {
+			put(structHash(v.dfval, d-1))
+			hs := make([]uint64, 0, len(v.data))
+			for k, e := range v.data {
+				hs = append(hs,
+					structHash(Import(k), d-1)*31+structHash(e, d-1))
+			}
+			putUnique(hs, put)
+		}
+	case *VSortMap:
+		put(uint64(v.root.count()))
+		if d > 0 {
+			put(structHash(v.dfval, d-1))
+			v.root.walk(func(n *smNode) {
+				put(structHash(n.key, d-1))
+				put(structHash(n.val, d-1))
+			})
+		}
+	case *VRecord:
+		fmt.Fprintf(h, "%p", v.Ctor)
+		if d > 0 {
+			for _, e := range v.Data {
+				put(structHash(e, d-1))
+			}
+		}
+	case *VMethVal:
+		fmt.Fprintf(h, "%p", v.Proc)
+		put(structHash(v.Val, d))
+	default:
+		switch k := GoKey(x).(type) {
+		case float64:
+			put(floatBits(k))
+		case complex128:
+			put(floatBits(real(k)))
+			put(floatBits(imag(k)))
+		case string, bigKey, ratKey, decKey, csetKey, bytesKey:
+			fmt.Fprintf(h, "%s", k)
+		default:
+			rv := reflect.ValueOf(x)
+			switch rv.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Map,
+				reflect.Ptr, reflect.Slice:
+				put(uint64(rv.Pointer()))
+			default:
+				fmt.Fprintf(h, "%#v", x)
+			}
+		}
+	}
+	return h.Sum64()
+}
+
+// putUnique(hs, put) passes the distinct values of hs to put, in order,
+// so that the result does not depend on the multiplicity of equal elements
+func putUnique(hs []uint64, put func(uint64)) {
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	for i, n := range hs {
+		if i == 0 || n != hs[i-1] {
+			put(n)
+		}
+	}
+}
+
+// floatBits(f) returns the bits of f, treating -0 the same as 0
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
@@ -21,7 +21,7 @@ var FileMethods = MethodTable([]*VProcedure{
 	DefMeth((*VFile).FClose, "close", "", "close file"),
 	DefMeth((*VFile).FGet, "get", "", "read one line"),
 	DefMeth((*VFile).FRead, "read", "", "read one line"),
-	DefMeth((*VFile).FReadb, "readb", "size", "read binary bytes"),
+	DefMeth((*VFile).FReadb, "readb", "size,t", "read binary bytes"),
 	DefMeth((*VFile).FWriteb, "writeb", "s", "write binary bytes"),
 	DefMeth((*VFile).FPut, "put", "x[]", "write values as lines"),
 	DefMeth((*VFile).FWrite, "write", "x[]", "write values and newline"),
@@ -106,7 +106,7 @@ func File(env *Env, args ...Value) (Value, *Closure) {
 		case 'f':
 			fail = true
 		default:
-			panic(NewExn("Unrecognized flag", string([]rune{f})))
+			panic(NewErr(ErrFlag, string([]rune{f})))
 		}
 	}
 
@@ -253,14 +253,15 @@ func (f *VFile) FRead(args ...Value) (Value, *Closure) {
 	}
 }
 
-// f.readb(n) reads up to n bytes into individual characters
-// without attempting any UTF-8 decoding.
+// f.readb(n, t) reads up to n bytes without attempting any UTF-8 decoding.
+// If t is the type bytes, the result is a bytes value; otherwise,
+// by default, each byte becomes one character of a string.
 // This is useful for reading binary files.
 // f.readb() fails at EOF when no more data is available.
 func (f *VFile) FReadb(args ...Value) (Value, *Closure) {
 	defer Traceback("f.readb", args)
 	if f.Reader == nil {
-		panic(NewExn("Not open for reading", f))
+		panic(NewErr(ErrNotReader, f))
 	}
 	n := IntVal(ProcArg(args, 0, ONE))
 	b := make([]byte, n)
@@ -269,22 +270,29 @@ func (f *VFile) FReadb(args ...Value) (Value, *Closure) {
 		return Fail()
 	} else if err != nil {
 		panic(err)
+	} else if ProcArg(args, 1, StringType) == BytesType {
+		return Return(&VBytes{b[:n:n], nil})
 	} else {
 		return Return(BinaryString(b[:n]))
 	}
 }
 
-// f.writeb(s) writes the string s to file f without any UTF-8 encoding.
-// Instead, the low 8 bits of each character are written as a single byte,
-// ignoring all other bits.
+// f.writeb(s) writes s to file f without any UTF-8 encoding.
+// If s is a bytes value, its bytes are written unchanged.
+// Otherwise s is converted to a string and the low 8 bits of each
+// character are written as a single byte, ignoring all other bits.
 // This is useful for writing binary files.
 func (f *VFile) FWriteb(args ...Value) (Value, *Closure) {
 	defer Traceback("f.writeb", args)
 	if f.Writer == nil {
-		panic(NewExn("Not open for writing", f))
+		panic(NewErr(ErrNotWriter, f))
+	}
+	x := ProcArg(args, 0, NilValue)
+	if b, ok := x.(*VBytes); ok {
+		Ock(f.Writer.Write(b.b))
+	} else {
+		Ock(f.Writer.Write(ToString(x).ToBinary()))
 	}
-	s := ToString(ProcArg(args, 0, NilValue))
-	Ock(f.Writer.Write(s.ToBinary()))
 	return Return(f)
 }
 
@@ -0,0 +1,47 @@
+//  file_test.go -- test that abandoned files are flushed and released
+
+package runtime
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// abandon opens name for buffered output, writes s, and drops the file
+func abandon(t *testing.T, name string, s string) {
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewFile(name, f, nil, bufio.NewWriter(f), f)
+	v.Write([]byte(s))
+}
+
+func TestAbandonedFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goaldi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := dir + "/out"
+	openLock.Lock()
+	n := len(openFiles)
+	openLock.Unlock()
+	abandon(t, name, "abandoned\n")
+	for i := 0; i < 100; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+		openLock.Lock()
+		m := len(openFiles)
+		openLock.Unlock()
+		b, _ := ioutil.ReadFile(name)
+		if m == n && string(b) == "abandoned\n" {
+			return
+		}
+	}
+	t.Fatalf("abandoned file not flushed and released")
+}
@@ -0,0 +1,298 @@
+//  fjson.go -- JSON encoding and decoding
+//
+//  JSON null, numbers, strings, arrays, and objects correspond to
+//  Goaldi nil, numbers, strings, lists, and tables (or records).
+//  JSON true and false become 1 and 0, as when importing Go booleans.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Declare procedures
+func init() {
+	DefLib(JSONEncode, "jsonencode", "x,indent", "encode value as JSON")
+	DefLib(JSONDecode, "jsondecode", "s,r", "decode JSON value")
+}
+
+// jsonencode(x, indent) returns a string encoding x as JSON.
+// Nil, numbers, strings, lists, sets, tables, and records are encoded;
+// sets become arrays, and tables and records become objects.
+// Table keys must be strings or numbers, no two alike when converted
+// to strings, and are sorted unless the table is ordered.
+// If indent is supplied, the output is spread over multiple lines,
+// with each level indented by that string, or by that many spaces
+// if indent is a number.
+// An exception is thrown for any other type of value or for a cycle.
+func JSONEncode(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("jsonencode", args)
+	x := ProcArg(args, 0, NilValue)
+	var b bytes.Buffer
+	jsonEncode(&b, x, make(map[Value]bool))
+	if indent := ProcArg(args, 1, NilValue); indent != NilValue {
+		ind := ""
+		if n, ok := indent.(*VNumber); ok {
+			ind = fmt.Sprintf("%*s", int(n.Val()), "")
+		} else {
+			ind = ToString(indent).ToUTF8()
+		}
+		var c bytes.Buffer
+		json.Indent(&c, b.Bytes(), "", ind)
+		b = c
+	}
+	return Return(NewString(b.String()))
+}
+
+// jsonEncode(b, x, active) appends the JSON encoding of x to buffer b.
+// The active map holds the structures being encoded, to detect cycles.
+func jsonEncode(b *bytes.Buffer, x Value, active map[Value]bool) {
+	switch v := x.(type) {
+	case *vnil:
+		b.WriteString("null")
+	case *VNumber:
+		f := v.Val()
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			panic(NewErr(ErrJSONEnc, x))
+		}
+		b.WriteString(v.GoString())
+	case *VBigInt, *VDecimal:
+		b.WriteString(fmt.Sprint(v))
+	case *VRational:
+		b.WriteString(strconv.FormatFloat(fval(v), 'g', -1, 64))
+	case *VString:
+		jsonQuote(b, v.ToUTF8())
+	case *VList:
+		jsonEnter(active, x)
+		b.WriteByte('[')
+		for i, e := range v.data {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			jsonEncode(b, e, active)
+		}
+		b.WriteByte(']')
+		delete(active, x)
+	case *VSet:
+		jsonEnter(active, x)
+		l, _ := v.Sort(ONE)
+		b.WriteByte('[')
+		for i, e := range l.(*VList).data {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			jsonEncode(b, e, active)
+		}
+		b.WriteByte(']')
+		delete(active, x)
+	case *VTable:
+		jsonEnter(active, x)
+		keys := make([]string, 0, len(v.data))
+		vals := make(map[string]Value)
+		for _, k := range v.keys() {
+			var s string
+			switch kv := Import(k).(type) {
+			case *VString:
+				s = kv.ToUTF8()
+			case *VNumber, *VBigInt, *VRational, *VDecimal:
+				s = fmt.Sprint(kv)
+			default:
+				panic(NewErr(ErrJSONEnc, kv))
+			}
+			if _, ok := vals[s]; ok {
+				panic(NewErrDetail(ErrJSONEnc, "duplicate key", Import(k)))
+			}
+			keys = append(keys, s)
+			vals[s] = v.data[k]
+		}
+		if v.order == nil {
+			sort.Strings(keys)
+		}
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			jsonQuote(b, k)
+			b.WriteByte(':')
+			jsonEncode(b, vals[k], active)
+		}
+		b.WriteByte('}')
+		delete(active, x)
+	case *VRecord:
+		jsonEnter(active, x)
+		b.WriteByte('{')
+		for i, e := range v.Data {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			jsonQuote(b, v.Ctor.Flist[i])
+			b.WriteByte(':')
+			jsonEncode(b, e, active)
+		}
+		b.WriteByte('}')
+		delete(active, x)
+	default:
+		panic(NewErr(ErrJSONEnc, x))
+	}
+}
+
+// jsonEnter(active, x) records x as being encoded, or panics on a cycle
+func jsonEnter(active map[Value]bool, x Value) {
+	if active[x] {
+		panic(NewErrDetail(ErrJSONEnc, "cycle", x))
+	}
+	active[x] = true
+}
+
+// jsonQuote(b, s) appends s to buffer b as a quoted JSON string
+func jsonQuote(b *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+	b.WriteByte('"')
+	for _, c := range s {
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(c)
+		case c == '\n':
+			b.WriteString(`\n`)
+		case c == '\r':
+			b.WriteString(`\r`)
+		case c == '\t':
+			b.WriteString(`\t`)
+		case c < 0x20 || c == 0x2028 || c == 0x2029:
+			b.WriteString(`\u`)
+			for k := 12; k >= 0; k -= 4 {
+				b.WriteByte(hex[(c>>uint(k))&0xF])
+			}
+		default:
+			b.WriteRune(c)
+		}
+	}
+	b.WriteByte('"')
+}
+
+// jsondecode(s, r) decodes the JSON value in string s.
+// JSON objects become tables, or if r is a record constructor,
+// records of that type, ignoring any keys that are not fields.
+// Only objects outside any other object become records;
+// objects within the fields of a record become tables.
+// Integers are decoded exactly, however large.
+// If s is a file, jsondecode generates successive JSON values read from it,
+// failing at end of file.
+// An exception is thrown for invalid JSON; its message gives the
+// byte offset of the error, counting from 1.
+func JSONDecode(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("jsondecode", args)
+	x := ProcArg(args, 0, NilValue)
+	var ctor *VCtor
+	if r := ProcArg(args, 1, NilValue); r != NilValue {
+		var ok bool
+		if ctor, ok = r.(*VCtor); !ok {
+			panic(NewErr(ErrType, r))
+		}
+	}
+	if f, ok := x.(*VFile); ok {
+		if f.Reader == nil {
+			panic(NewErr(ErrNotReader, f))
+		}
+		d := json.NewDecoder(f.Reader)
+		d.UseNumber()
+		var c *Closure
+		c = &Closure{func() (Value, *Closure) {
+			v, ok := jsonNext(d, ctor)
+			if !ok {
+				return Fail()
+			}
+			return v, c
+		}}
+		return c.Resume()
+	}
+	s := ToString(x).ToUTF8()
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	v, ok := jsonNext(d, ctor)
+	if !ok {
+		panic(NewErrDetail(ErrJSONDec,
+			fmt.Sprintf("offset %d: no value", len(s)+1)))
+	}
+	off := int(d.InputOffset())
+	if t := strings.TrimLeft(s[off:], " \t\r\n"); t != "" {
+		panic(NewErrDetail(ErrJSONDec, fmt.Sprintf(
+			"offset %d: data after value", len(s)-len(t)+1)))
+	}
+	return Return(v)
+}
+
+// jsonNext(d, ctor) decodes the next value from d,
+// returning false at EOF and panicking on an error
+func jsonNext(d *json.Decoder, ctor *VCtor) (Value, bool) {
+	var v interface{}
+	err := d.Decode(&v)
+	if err == io.EOF {
+		return nil, false
+	}
+	if err != nil {
+		if e, ok := err.(*json.SyntaxError); ok {
+			panic(NewErrDetail(ErrJSONDec,
+				fmt.Sprintf("offset %d: %s", e.Offset, e.Error())))
+		}
+		if err == io.ErrUnexpectedEOF {
+			// report the position just past the input
+			n, _ := io.Copy(ioutil.Discard, d.Buffered())
+			panic(NewErrDetail(ErrJSONDec, fmt.Sprintf(
+				"offset %d: unexpected end of input", d.InputOffset()+n+1)))
+		}
+		panic(err)
+	}
+	return jsonValue(v, ctor), true
+}
+
+// jsonValue(v, ctor) converts a decoded JSON value into a Goaldi value,
+// making objects into records if ctor is not nil
+func jsonValue(v interface{}, ctor *VCtor) Value {
+	switch x := v.(type) {
+	case nil:
+		return NilValue
+	case bool:
+		return Import(x)
+	case json.Number:
+		n, err := ParseNumber(string(x))
+		if err != nil {
+			panic(NewErr(ErrJSONDec, NewString(string(x))))
+		}
+		return n
+	case string:
+		return NewString(x)
+	case []interface{}:
+		l := make([]Value, len(x))
+		for i, e := range x {
+			l[i] = jsonValue(e, ctor)
+		}
+		return InitList(l)
+	case map[string]interface{}:
+		if ctor != nil {
+			r := ctor.New(nil)
+			for k, e := range x {
+				if i := ctor.Fmap[k]; i > 0 {
+					r.Data[i-1] = jsonValue(e, nil)
+				}
+			}
+			return r
+		}
+		t := NewTable(NilValue)
+		for k, e := range x {
+			t.data[k] = jsonValue(e, ctor)
+		}
+		return t
+	}
+	panic(NewErr(ErrMalfunction, Import(v)))
+}
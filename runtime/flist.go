@@ -17,7 +17,7 @@ var ListMethods = MethodTable([]*VProcedure{
 	DefMeth((*VList).Get, "get", "", "remove from front"),
 	DefMeth((*VList).Put, "put", "x[]", "add to end"),
 	DefMeth((*VList).Pull, "pull", "", "remove from end"),
-	DefMeth((*VList).Sort, "sort", "i", "return sorted copy"),
+	DefMeth(ListSort, "sort", "i,key,desc", "return sorted copy"),
 	DefMeth((*VList).Shuffle, "shuffle", "", "return randomized copy"),
 })
 
@@ -74,34 +74,148 @@ func (v *VList) Shuffle(args ...Value) (Value, *Closure) {
 	return Return(InitList(d))
 }
 
-// L.sort(i) returns a copy of list L in which the elements have been sorted.
+// L.sort(i, key, desc) returns a copy of list L in which the elements
+// have been sorted.
 // Values are ordered first by type, then within types by their values.
 // Among lists and among records of the same type,
 // ordering is based on field i.
 // Lists with no element i are sorted ahead of lists that have one.
 // The value i defaults to 1 and must be strictly positive.
-func (v *VList) Sort(args ...Value) (Value, *Closure) {
+// Instead of a field index, i may be a procedure p, in which case
+// x is placed ahead of y if p(x,y) succeeds.
+// For a multi-key sort, i may be a list of field indexes and procedures;
+// each later entry orders the elements left equal by those before it.
+// If key is supplied, it is a procedure that is called once for each
+// element x, and elements are ordered by key(x) instead of by x;
+// a key is nil if the call fails.
+// If desc is supplied and not nil, the order is reversed.
+// The sort is stable: elements that are ordered equally remain in
+// their original order, even if desc is supplied.
+// An exception thrown by a procedure is passed on to the caller.
+func ListSort(env *Env, v *VList, args ...Value) (Value, *Closure) {
 	defer Traceback("sort", args)
-	i := IntVal(ProcArg(args, 0, ONE)) - 1
-	if i < 0 {
-		panic(NewExn("Nonpositive field index", args[0]))
+	return Return(InitList(sortValues(env, v.data, args)))
+}
+
+// VList.Sort(i, key, desc) sorts L outside any calling environment
+func (v *VList) Sort(args ...Value) (Value, *Closure) {
+	return ListSort(nil, v, args...)
+}
+
+// sortValues(env, data, args) returns a sorted copy of data,
+// interpreting the arguments i, key, and desc as for L.sort()
+func sortValues(env *Env, data []Value, args []Value) []Value {
+	d := &lsort{v: make([]Value, len(data)), env: env}
+	copy(d.v, data)
+	d.c = sortCriteria(ProcArg(args, 0, ONE))
+	d.desc = ProcArg(args, 2, NilValue) != NilValue
+	key := ProcArg(args, 1, NilValue)
+	if d.env == nil { // if called from Go, any procedure needs an environment
+		calls := key != NilValue
+		for _, c := range d.c {
+			calls = calls || c.p != nil
+		}
+		if calls {
+			d.env = NewEnv(nil)
+		}
+	}
+	if key != NilValue {
+		p, ok := key.(ICall)
+		if !ok {
+			panic(NewErr(ErrType, key))
+		}
+		d.k = make([]Value, len(data))
+		for i, x := range d.v {
+			d.k[i], _ = p.Call(d.env, []Value{x}, []string{})
+			if d.k[i] == nil {
+				d.k[i] = NilValue
+			}
+		}
 	}
-	d := &lsort{make([]Value, len(v.data)), i}
-	copy(d.v, v.data)
 	sort.Stable(d)
-	return Return(InitList(d.v))
+	return d.v
 }
 
-// a list to be sorted, with field index
+// a sorting criterion: a field index or a comparison procedure
+type sortCrit struct {
+	f int   // zero-based field index, if no procedure
+	p ICall // comparison procedure, or nil
+}
+
+// sortCriteria(i) interprets i as a criterion or a nonempty list of criteria
+func sortCriteria(i Value) []sortCrit {
+	if l, ok := i.(*VList); ok {
+		if len(l.data) == 0 {
+			panic(NewErr(ErrNoCrit, i))
+		}
+		c := make([]sortCrit, len(l.data))
+		for j := range l.data {
+			c[j] = sortCriterion(l.Elem(j))
+		}
+		return c
+	}
+	return []sortCrit{sortCriterion(i)}
+}
+
+// sortCriterion(i) interprets i as a procedure or a field index
+func sortCriterion(i Value) sortCrit {
+	if p, ok := i.(ICall); ok {
+		if _, ok := i.(*VNumber); !ok { // numbers are callable, too
+			return sortCrit{-1, p}
+		}
+	}
+	f := IntVal(i) - 1
+	if f < 0 {
+		panic(NewErr(ErrFieldIdx, i))
+	}
+	return sortCrit{f, nil}
+}
+
+// sortCrit.less(env, x, y) reports whether x precedes y under criterion c
+func (c sortCrit) less(env *Env, x Value, y Value) bool {
+	if c.p == nil {
+		return LT(x, y, c.f)
+	}
+	v, _ := c.p.Call(env, []Value{x, y}, []string{})
+	return v != nil
+}
+
+// a list to be sorted, with its sort keys and criteria
 type lsort struct {
-	v []Value // Goaldi values
-	f int     // zero-based field index, or -1
+	v    []Value    // Goaldi values
+	k    []Value    // sort keys, or nil to sort on the values
+	c    []sortCrit // criteria, most significant first
+	desc bool       // reverse the order?
+	env  *Env       // environment for calling procedures
 }
 
 // sort interface functions
-func (a *lsort) Len() int           { return len(a.v) }
-func (a *lsort) Swap(i, j int)      { a.v[i], a.v[j] = a.v[j], a.v[i] }
-func (a *lsort) Less(i, j int) bool { return LT(a.v[i], a.v[j], a.f) }
+func (a *lsort) Len() int { return len(a.v) }
+
+func (a *lsort) Swap(i, j int) {
+	a.v[i], a.v[j] = a.v[j], a.v[i]
+	if a.k != nil {
+		a.k[i], a.k[j] = a.k[j], a.k[i]
+	}
+}
+
+func (a *lsort) Less(i, j int) bool {
+	s := a.v
+	if a.k != nil {
+		s = a.k
+	}
+	if a.desc {
+		i, j = j, i
+	}
+	for n, c := range a.c {
+		if c.less(a.env, s[i], s[j]) {
+			return true
+		} else if n == len(a.c)-1 || c.less(a.env, s[j], s[i]) {
+			return false
+		}
+	}
+	return false
+}
 
 // LT(x, y, i) -- return x < y on field i
 func LT(x Value, y Value, i int) bool {
@@ -16,11 +16,12 @@ import (
 func init() {
 	// Goaldi procedures
 	DefLib(Copy, "copy", "x", "copy value")
-	DefLib(Image, "image", "x", "return detailed string image")
+	DefLib(Image, "image", "x,d", "return detailed string image")
 	DefLib(NoResult, "noresult", "e", "fail immediately")
 	DefLib(NilResult, "nilresult", "e", "return nil")
 	DefLib(ErrResult, "errresult", "e", "return e")
 	DefLib(Exit, "exit", "i", "terminate program with exit status")
+	DefLib(AtExit, "atexit", "p", "register procedure to call at termination")
 	DefLib(Throw, "throw", "e,x[]", "terminate with error and offending values")
 	DefLib(Sleep, "sleep", "n", "pause execution momentarily")
 	DefLib(Date, "date", "", "return the current date")
@@ -54,12 +55,30 @@ func Copy(env *Env, args ...Value) (Value, *Closure) {
 	return Return(y.Interface())
 }
 
-// image(x) returns a string image of x.
+// image(x, d) returns a string image of x.
 // This is the same conversion applied by sprintf("%#v",x)
 // and is typically more verbose and detailed than the result of string(x).
+//
+// For a structure, d gives the depth to which nested values are shown
+// as images; deeper values are shown as by string(x).
+// The default depth of 1 shows only x itself as an image.
 func Image(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("image", args)
-	return Return(NewString(fmt.Sprintf("%#v", ProcArg(args, 0, NilValue))))
+	x := ProcArg(args, 0, NilValue)
+	d := IntVal(ProcArg(args, 1, ONE))
+	return Return(NewString(ImageDepth(x, d)))
+}
+
+// ImageDepth(x, d) returns the image of x with nested values shown to depth d.
+// If d < 1, it returns the string form of x instead.
+func ImageDepth(x Value, d int) string {
+	if d < 1 {
+		return fmt.Sprintf("%v", x)
+	} else if v, ok := x.(IImage); ok {
+		return v.Image(d)
+	} else {
+		return fmt.Sprintf("%#v", x)
+	}
 }
 
 // noresult(e) fails immediately, ignoring e.
@@ -160,6 +179,9 @@ func CPUtime(env *Env, args ...Value) (Value, *Closure) {
 // exit(i) terminates execution and returns exit status i,
 // truncated to integer, to the system.
 // A status of 0 signifies normal termination.
+//
+// Before terminating, exit(i) calls any procedures registered by atexit()
+// and then flushes and closes all open files.
 func Exit(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("exit", args)
 	Shutdown(IntVal(ProcArg(args, 0, ZERO)))
@@ -170,7 +192,9 @@ func Exit(env *Env, args ...Value) (Value, *Closure) {
 // with error value e and zero or more offending values.
 // If not caught, the exception terminates execution.
 //
-// If e is a number or string, a Goaldi exception is created using e.
+// If e is a string, a Goaldi exception is created using e as the message.
+// If e is a number, it is taken as an error code, and the exception
+// uses the corresponding standard message (see errortext()).
 // Otherwise, the value e is thrown directly, without interpretation.
 func Throw(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("throw", args)
@@ -179,7 +203,7 @@ func Throw(env *Env, args ...Value) (Value, *Closure) {
 	case *VString:
 		panic(NewExn(v.String(), args[1:]...))
 	case *VNumber:
-		panic(NewExn(fmt.Sprintf("Fatal error %v", v), args[1:]...))
+		panic(NewErr(ErrCode(v.Val()), args[1:]...))
 	default:
 		panic(x)
 	}
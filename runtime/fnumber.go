@@ -7,6 +7,8 @@ package runtime
 import (
 	"encoding/binary"
 	"math"
+	"math/big"
+	"math/cmplx"
 	"math/rand"
 	"os"
 )
@@ -14,6 +16,8 @@ import (
 func init() {
 	// Goaldi procedures
 	DefLib(Seq, "seq", "n,incr", "produce n to infinity")
+	DefLib(Abs, "abs", "n", "compute absolute value")
+	DefLib(Integer, "integer", "n", "truncate to integer")
 	DefLib(Log, "log", "n,b", "compute logarithm to base b")
 	DefLib(Atan, "atan", "y,x", "compute arctangent of y / x")
 	DefLib(Randomize, "randomize", "", "irreproducibly seed random generation")
@@ -26,27 +30,25 @@ func init() {
 	DefLib(IClear, "iclear", "i,j", "compute bitwise clear of i by j")
 	DefLib(ICom, "icom", "i", "compute bitwise complement")
 	DefLib(IShift, "ishift", "i,j", "compute bitwise shift of i by j")
+	DefLib(Sqrt, "sqrt", "n", "compute square root")
+	DefLib(Exp, "exp", "n", "return e ^ x")
+	DefLib(Sin, "sin", "n", "compute sine")
+	DefLib(Cos, "cos", "n", "compute cosine")
+	DefLib(Tan, "tan", "n", "compute tangent")
+	DefLib(Asin, "asin", "n", "compute arcsine")
+	DefLib(Acos, "acos", "n", "compute arccosine")
+	DefLib(Sinh, "sinh", "n", "compute hyperbolic sine")
+	DefLib(Cosh, "cosh", "n", "compute hyperbolic cosine")
+	DefLib(Tanh, "tanh", "n", "compute hyperbolic tangent")
+	DefLib(Asinh, "asinh", "n", "compute hyperbolic arcsine")
+	DefLib(Acosh, "acosh", "n", "compute hyperbolic arccosine")
+	DefLib(Atanh, "atanh", "n", "compute hyperbolic arctangent")
 	// Go library functions
-	GoLib(math.Abs, "abs", "n", "compute absolute value")
 	GoLib(math.Ceil, "ceil", "n", "round up to integer")
 	GoLib(math.Floor, "floor", "n", "round down to integer")
-	GoLib(math.Trunc, "integer", "n", "truncate to integer")
 	GoLib(math.Cbrt, "cbrt", "n", "compute cube root")
-	GoLib(math.Sqrt, "sqrt", "n", "compute square root")
 	GoLib(math.Hypot, "hypot", "x,y", "return sqrt of x^2 + y^2")
-	GoLib(math.Exp, "exp", "n", "return e ^ x")
 	GoLib(rand.Seed, "seed", "n", "set random number seed")
-	GoLib(math.Sin, "sin", "n", "compute sine")
-	GoLib(math.Cos, "cos", "n", "compute cosine")
-	GoLib(math.Tan, "tan", "n", "compute tangent")
-	GoLib(math.Asin, "asin", "n", "compute arcsine")
-	GoLib(math.Acos, "acos", "n", "compute arccosine")
-	GoLib(math.Sinh, "sinh", "n", "compute hyperbolic sine")
-	GoLib(math.Cosh, "cosh", "n", "compute hyperbolic cosine")
-	GoLib(math.Tanh, "tanh", "n", "compute hyperbolic tangent")
-	GoLib(math.Asinh, "asinh", "n", "compute hyperbolic arcsine")
-	GoLib(math.Acosh, "acosh", "n", "compute hyperbolic arccosine")
-	GoLib(math.Atanh, "atanh", "n", "compute hyperbolic arccosine")
 }
 
 // number(x) returns its argument converted to number,
@@ -55,17 +57,142 @@ func init() {
 // number() trims leading and trailing spaces
 // and then accepts standard Go decimal forms (fixed and floating)
 // or Goaldi radix forms (101010b, 52o, 2Ax, 23r1J).
+// Integers of any length are converted exactly.
 func Number(env *Env, args ...Value) (Value, *Closure) {
 	// nonstandard entry; on panic, returns default nil values to fail
 	defer func() { recover() }()
 	v := ProcArg(args, 0, NilValue)
-	if n, ok := v.(Numerable); ok {
-		return Return(n.ToNumber())
+	if _, ok := v.(Numerable); ok {
+		return Return(numval(v))
 	} else {
-		return Return(Import(v).(Numerable).ToNumber())
+		return Return(numval(Import(v)))
 	}
 }
 
+// abs(n) returns the absolute value of n.
+// For a complex number, this is its magnitude.
+func Abs(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("abs", args)
+	n := numval(ProcArg(args, 0, NilValue))
+	switch v := n.(type) {
+	case *VBigInt:
+		return Return(NewInteger(new(big.Int).Abs(v.Int())))
+	case *VRational:
+		return Return(NewRational(new(big.Rat).Abs(v.Rat())))
+	case *VDecimal:
+		return Return(&VDecimal{new(big.Int).Abs(v.unscaled), v.scale})
+	case *VComplex:
+		return Return(NewNumber(cmplx.Abs(v.Val())))
+	}
+	return Return(NewNumber(math.Abs(fval(n))))
+}
+
+// integer(n) truncates n to an integer, rounding toward zero.
+// A rational or decimal value is truncated exactly.
+// Infinities and NaN are returned unchanged.
+func Integer(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("integer", args)
+	n := numval(ProcArg(args, 0, NilValue))
+	switch v := n.(type) {
+	case *VBigInt:
+		return Return(v)
+	case *VRational, *VDecimal:
+		return Return(NewInteger(ratTrunc(ratval(v))))
+	}
+	return Return(NewNumber(math.Trunc(fval(n))))
+}
+
+// mathfn(x, f, c) applies f to a real number x
+// or c to a complex number x
+func mathfn(x Value, f func(float64) float64,
+	c func(complex128) complex128) Value {
+	n := numval(x)
+	if z, ok := n.(*VComplex); ok {
+		return NewComplex(c(z.Val()))
+	}
+	return NewNumber(f(fval(n)))
+}
+
+// sqrt(n) returns the square root of n.
+// A negative real n produces NaN; for the square root of a negative
+// number, use a complex argument: sqrt(complex(-4)) is 0+2i.
+func Sqrt(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("sqrt", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Sqrt, cmplx.Sqrt))
+}
+
+// exp(n) returns e raised to the power n, which may be complex.
+func Exp(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("exp", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Exp, cmplx.Exp))
+}
+
+// sin(n) returns the sine of the radian argument n, which may be complex.
+func Sin(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("sin", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Sin, cmplx.Sin))
+}
+
+// cos(n) returns the cosine of the radian argument n, which may be complex.
+func Cos(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("cos", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Cos, cmplx.Cos))
+}
+
+// tan(n) returns the tangent of the radian argument n, which may be complex.
+func Tan(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("tan", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Tan, cmplx.Tan))
+}
+
+// asin(n) returns the arcsine, in radians, of n, which may be complex.
+func Asin(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("asin", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Asin, cmplx.Asin))
+}
+
+// acos(n) returns the arccosine, in radians, of n, which may be complex.
+func Acos(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("acos", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Acos, cmplx.Acos))
+}
+
+// sinh(n) returns the hyperbolic sine of n, which may be complex.
+func Sinh(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("sinh", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Sinh, cmplx.Sinh))
+}
+
+// cosh(n) returns the hyperbolic cosine of n, which may be complex.
+func Cosh(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("cosh", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Cosh, cmplx.Cosh))
+}
+
+// tanh(n) returns the hyperbolic tangent of n, which may be complex.
+func Tanh(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("tanh", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Tanh, cmplx.Tanh))
+}
+
+// asinh(n) returns the hyperbolic arcsine of n, which may be complex.
+func Asinh(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("asinh", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Asinh, cmplx.Asinh))
+}
+
+// acosh(n) returns the hyperbolic arccosine of n, which may be complex.
+func Acosh(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("acosh", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Acosh, cmplx.Acosh))
+}
+
+// atanh(n) returns the hyperbolic arctangent of n, which may be complex.
+func Atanh(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("atanh", args)
+	return Return(mathfn(ProcArg(args, 0, NilValue), math.Atanh, cmplx.Atanh))
+}
+
 // seq(n,incr) generates an endless sequence of values beginning at n
 // with increments of incr.
 func Seq(env *Env, args ...Value) (Value, *Closure) {
@@ -78,10 +205,21 @@ func Seq(env *Env, args ...Value) (Value, *Closure) {
 // log(n, b) returns the logarithm of n to base b.
 // The default value of b is %e (2.7183...),
 // so log(n) returns the natural logarithm of n.
+// If either argument is complex, the result is the complex logarithm.
 func Log(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("log", args)
-	r1 := FloatVal(ProcArg(args, 0, NilValue))
-	r2 := FloatVal(ProcArg(args, 1, E))
+	n := numval(ProcArg(args, 0, NilValue))
+	b := numval(ProcArg(args, 1, E))
+	if z := complexArith(n, b, func(x, y complex128) complex128 {
+		if y == math.E {
+			return cmplx.Log(x)
+		}
+		return cmplx.Log(x) / cmplx.Log(y)
+	}); z != nil {
+		return Return(z)
+	}
+	r1 := fval(n)
+	r2 := fval(b)
 	if r2 == math.E {
 		return Return(NewNumber(math.Log(r1)))
 	} else {
@@ -147,38 +285,59 @@ func RtoD(env *Env, args ...Value) (Value, *Closure) {
 	return Return(NewNumber(r * 180.0 / math.Pi))
 }
 
+// ival(x) converts x to number and truncates it to an integer,
+// returning a big.Int.  It panics if x is infinite or NaN.
+func ival(x Value) *big.Int {
+	n := numval(x)
+	if z := bigval(n); z != nil {
+		return z
+	}
+	switch v := n.(type) {
+	case *VRational, *VDecimal:
+		return ratTrunc(ratval(v))
+	}
+	f := math.Trunc(fval(n))
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		panic(NewErr(ErrNotInt, x))
+	}
+	z, _ := big.NewFloat(f).Int(nil)
+	return z
+}
+
 // iand(i, j) returns the bitwise AND of the values i and j truncated to integer.
+// Like the other bitwise procedures, iand() operates exactly on integers
+// of any size, treating negative values as two's complement.
 func IAnd(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("iand", args)
-	i := int64(FloatVal(ProcArg(args, 0, NilValue)))
-	j := int64(FloatVal(ProcArg(args, 1, NilValue)))
-	return Return(NewNumber(float64(i & j)))
+	i := ival(ProcArg(args, 0, NilValue))
+	j := ival(ProcArg(args, 1, NilValue))
+	return Return(NewInteger(new(big.Int).And(i, j)))
 }
 
 // ior(i, j) returns the bitwise OR of the values i and j truncated to integer.
 func IOr(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("ior", args)
-	i := int64(FloatVal(ProcArg(args, 0, NilValue)))
-	j := int64(FloatVal(ProcArg(args, 1, NilValue)))
-	return Return(NewNumber(float64(i | j)))
+	i := ival(ProcArg(args, 0, NilValue))
+	j := ival(ProcArg(args, 1, NilValue))
+	return Return(NewInteger(new(big.Int).Or(i, j)))
 }
 
 // ixor(i, j) returns the bitwise exclusive OR
 // of the values i and j truncated to integer.
 func IXor(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("ixor", args)
-	i := int64(FloatVal(ProcArg(args, 0, NilValue)))
-	j := int64(FloatVal(ProcArg(args, 1, NilValue)))
-	return Return(NewNumber(float64(i ^ j)))
+	i := ival(ProcArg(args, 0, NilValue))
+	j := ival(ProcArg(args, 1, NilValue))
+	return Return(NewInteger(new(big.Int).Xor(i, j)))
 }
 
 // iclear(i, j) returns the value of i cleared of those bits set in j,
 // after truncating both arguments to integer.
 func IClear(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("iclear", args)
-	i := int64(FloatVal(ProcArg(args, 0, NilValue)))
-	j := int64(FloatVal(ProcArg(args, 1, NilValue)))
-	return Return(NewNumber(float64(i &^ j)))
+	i := ival(ProcArg(args, 0, NilValue))
+	j := ival(ProcArg(args, 1, NilValue))
+	return Return(NewInteger(new(big.Int).AndNot(i, j)))
 }
 
 // ishift(i, j) shifts i by j bits and returns the result.
@@ -187,18 +346,18 @@ func IClear(env *Env, args ...Value) (Value, *Closure) {
 // The arguments are both truncated to integer before operating.
 func IShift(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("ishift", args)
-	i := int64(FloatVal(ProcArg(args, 0, NilValue)))
-	j := int64(FloatVal(ProcArg(args, 1, NilValue)))
+	i := ival(ProcArg(args, 0, NilValue))
+	j := IntVal(ProcArg(args, 1, NilValue))
 	if j > 0 {
-		return Return(NewNumber(float64(i << uint(j))))
+		return Return(NewInteger(new(big.Int).Lsh(i, uint(j))))
 	} else {
-		return Return(NewNumber(float64(i >> uint(-j))))
+		return Return(NewInteger(new(big.Int).Rsh(i, uint(-j))))
 	}
 }
 
 // icom(i) truncates i to integer and returns its bitwise complement.
 func ICom(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("icom", args)
-	i := int64(FloatVal(ProcArg(args, 0, NilValue)))
-	return Return(NewNumber(float64(^i)))
+	i := ival(ProcArg(args, 0, NilValue))
+	return Return(NewInteger(new(big.Int).Not(i)))
 }
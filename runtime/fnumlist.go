@@ -82,12 +82,12 @@ func Gmean(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("gmean", args)
 	p := FloatVal(ProcArg(args, 0, NilValue))
 	if p <= 0 {
-		panic(NewExn("Nonpositive argument", p))
+		panic(NewErr(ErrNonPos, p))
 	}
 	for i := 1; i < len(args); i++ {
 		v := FloatVal(ProcArg(args, i, NilValue))
 		if v <= 0 {
-			panic(NewExn("Nonpositive argument", v))
+			panic(NewErr(ErrNonPos, v))
 		}
 		p *= v
 	}
@@ -100,13 +100,13 @@ func Hmean(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("hmean", args)
 	v := FloatVal(ProcArg(args, 0, NilValue))
 	if v <= 0 {
-		panic(NewExn("Nonpositive argument", v))
+		panic(NewErr(ErrNonPos, v))
 	}
 	t := 1 / v
 	for i := 1; i < len(args); i++ {
 		v = FloatVal(ProcArg(args, i, NilValue))
 		if v <= 0 {
-			panic(NewExn("Nonpositive argument", v))
+			panic(NewErr(ErrNonPos, v))
 		}
 		t += 1 / v
 	}
@@ -0,0 +1,289 @@
+//  fparse.go -- reading values back from their images
+//
+//  parse() accepts the notation produced by image() for nil, numbers,
+//  strings, csets, bytes, lists, sets, tables, and records.
+//  Nested values are reconstructed only if they were imaged in full,
+//  which requires a depth argument to image() for structures.
+
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Declare procedures
+func init() {
+	DefLib(Parse, "parse", "s", "reconstruct value from image")
+}
+
+// parse(s) returns the value whose image is s, reversing image().
+// Records are constructed by looking up their type names among the
+// global declarations of the program, searching the unnamed namespace
+// first, then other namespaces, and finally the standard library.
+// Tables are created with a default value of nil.
+// An exception is thrown if s is not a valid image;
+// its message gives the offset of the error, counting from 1.
+func Parse(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("parse", args)
+	p := &parser{s: ToString(ProcArg(args, 0, NilValue)).ToUTF8()}
+	v := p.value()
+	if p.skip(); p.i < len(p.s) {
+		p.fail("unexpected text")
+	}
+	return Return(v)
+}
+
+// parser holds the state of a parse: the input and the current index
+type parser struct {
+	s string
+	i int
+}
+
+// parser.fail(msg) throws an exception for an error at the current offset
+func (p *parser) fail(msg string) {
+	panic(NewErrDetail(ErrParse, fmt.Sprintf("offset %d: %s", p.i+1, msg),
+		NewString(p.s)))
+}
+
+// parser.skip() advances past any white space
+func (p *parser) skip() {
+	for p.i < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.i]) >= 0 {
+		p.i++
+	}
+}
+
+// parser.peek() skips white space and returns the next byte, or 0 at end
+func (p *parser) peek() byte {
+	p.skip()
+	if p.i < len(p.s) {
+		return p.s[p.i]
+	}
+	return 0
+}
+
+// parser.expect(c) consumes byte c or fails
+func (p *parser) expect(c byte) {
+	if p.peek() != c {
+		p.fail(fmt.Sprintf("expected %q", c))
+	}
+	p.i++
+}
+
+// parser.word() consumes and returns a name or number, possibly empty.
+// A word may include a "::" namespace qualifier, a leading sign,
+// and a signed exponent.
+func (p *parser) word() string {
+	p.skip()
+	j := p.i
+	for k := p.i; k < len(p.s); k++ {
+		c := p.s[k]
+		switch {
+		case c == '+' || c == '-':
+			if k > j && strings.IndexByte("eE", p.s[k-1]) < 0 {
+				p.i = k
+				return p.s[j:k]
+			}
+		case c == ':':
+			if !strings.HasPrefix(p.s[k:], "::") {
+				p.i = k
+				return p.s[j:k]
+			}
+			k++
+		case c == '_' || c == '.' || c >= utf8.RuneSelf ||
+			'0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+		default:
+			p.i = k
+			return p.s[j:k]
+		}
+	}
+	p.i = len(p.s)
+	return p.s[j:]
+}
+
+// parser.quoted() consumes and returns the contents of a quoted string
+func (p *parser) quoted() string {
+	p.skip()
+	j := p.i
+	for k := j + 1; k < len(p.s); k++ {
+		switch p.s[k] {
+		case '\\':
+			k++
+		case '"':
+			s, err := strconv.Unquote(p.s[j : k+1])
+			if err != nil {
+				p.fail("malformed string")
+			}
+			p.i = k + 1
+			return s
+		}
+	}
+	p.fail("unterminated string")
+	return ""
+}
+
+// parser.value() consumes and returns the next value
+func (p *parser) value() Value {
+	switch p.peek() {
+	case 0:
+		p.fail("missing value")
+	case '"':
+		return NewString(p.quoted())
+	case '~':
+		p.i++
+		return NilValue
+	case '[':
+		p.i++
+		l := make([]Value, 0)
+		p.elements(']', func() {
+			l = append(l, p.value())
+		})
+		return InitList(l)
+	}
+	j := p.i
+	w := p.word()
+	switch {
+	case w == "":
+		p.fail("unexpected character")
+	case w == "nil":
+		return NilValue
+	case p.peek() == '(':
+		return p.call(w, j)
+	case p.peek() == '{':
+		return p.structure(w, j)
+	}
+	n, err := ParseNumber(w)
+	if err != nil {
+		p.i = j
+		p.fail("malformed value")
+	}
+	return n
+}
+
+// parser.elements(c, f) calls f for each element of a comma-separated
+// sequence terminated by c
+func (p *parser) elements(c byte, f func()) {
+	if p.peek() == c {
+		p.i++
+		return
+	}
+	for {
+		f()
+		if p.peek() == c {
+			p.i++
+			return
+		}
+		if p.peek() != ',' {
+			p.fail(fmt.Sprintf("expected ',' or %q", c))
+		}
+		p.i++
+	}
+}
+
+// parser.call(name, j) consumes a parenthesized constructor argument
+// following a name at index j and returns the resulting value
+func (p *parser) call(name string, j int) Value {
+	p.expect('(')
+	var arg string
+	if p.peek() == '"' {
+		arg = p.quoted()
+	} else if k := strings.IndexByte(p.s[p.i:], ')'); k >= 0 {
+		arg = p.s[p.i : p.i+k]
+		p.i += k
+	}
+	var v Value
+	switch name {
+	case "rational":
+		v, _ = Rational(nil, NewString(arg))
+	case "decimal":
+		v, _ = Decimal(nil, NewString(arg))
+	case "complex":
+		v, _ = Complex(nil, NewString(arg))
+	case "cset":
+		v, _ = Cset(nil, NewString(arg))
+	case "bytes":
+		v = &VBytes{[]byte(arg), nil}
+	default:
+		p.i = j
+		p.fail("cannot construct " + name)
+	}
+	if v == nil {
+		p.fail("malformed " + name)
+	}
+	p.expect(')')
+	return v
+}
+
+// parser.structure(name, j) consumes a braced set, table, or record
+// following a name at index j
+func (p *parser) structure(name string, j int) Value {
+	p.expect('{')
+	switch name {
+	case "set":
+		S := NewSet(EMPTYLIST)
+		p.elements('}', func() {
+			S.add(p.value())
+		})
+		return S
+	case "table":
+		T := NewTable(NilValue)
+		p.elements('}', func() {
+			k := p.value()
+			p.expect(':')
+			T.data[GoKey(k)] = p.value()
+		})
+		return T
+	}
+	names := make([]string, 0)
+	posns := make([]int, 0)
+	vals := make([]Value, 0)
+	p.elements('}', func() {
+		p.skip()
+		posns = append(posns, p.i)
+		f := p.word()
+		if f == "" {
+			p.fail("expected field name")
+		}
+		names = append(names, f)
+		p.expect(':')
+		vals = append(vals, p.value())
+	})
+	var ctor *VCtor
+	if name == "tuple" {
+		ctor = TupleType(names)
+	} else if ctor = findCtor(name); ctor == nil {
+		p.i = j
+		p.fail("unknown record type " + name)
+	}
+	r := ctor.New(nil)
+	for k, f := range names {
+		i := ctor.Fmap[f]
+		if i == 0 {
+			p.i = posns[k]
+			p.fail("no field " + f + " in " + name)
+		}
+		r.Data[i-1] = vals[k]
+	}
+	return r
+}
+
+// findCtor(name) finds the record constructor for a type name,
+// possibly qualified by a namespace, or returns nil
+func findCtor(name string) *VCtor {
+	if k := strings.Index(name, "::"); k >= 0 {
+		if ns := allSpaces[name[:k]]; ns != nil {
+			c, _ := Deref(ns.Get(name[k+2:])).(*VCtor)
+			return c
+		}
+		return nil
+	}
+	for s := range AllSpaces() { // "" sorts first
+		if c, ok := Deref(allSpaces[s].Get(name)).(*VCtor); ok {
+			return c
+		}
+	}
+	c, _ := StdLib[name].(*VCtor)
+	return c
+}
@@ -0,0 +1,49 @@
+//  fpqueue.go -- priority queue functions and methods
+
+package runtime
+
+// Declare methods
+var PQueueMethods = MethodTable([]*VProcedure{
+	DefMeth((*VPQueue).Put, "put", "x[]", "add values"),
+	DefMeth((*VPQueue).Get, "get", "", "remove first value"),
+	DefMeth((*VPQueue).Peek, "peek", "", "return first value"),
+})
+
+// pqueue(cmp) creates a new, empty priority queue.
+// If cmp is an integer i, values are ordered as by L.sort(i),
+// so that lists and records are ordered by field i; the default is 1.
+// Otherwise cmp is a procedure, and a value x is removed ahead of y
+// if cmp(x,y) succeeds.
+// Values that are ordered equally are removed in no particular order.
+// A queue may be shared among co-expressions;
+// cmp must not itself use the queue.
+func PQueue(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("pqueue", args)
+	Q := NewPQueue(0)
+	Q.crit = sortCriterion(ProcArg(args, 0, ONE))
+	Q.env = env
+	return Return(Q)
+}
+
+// Q.put(x...) adds its arguments to priority queue Q and returns Q.
+func (Q *VPQueue) Put(args ...Value) (Value, *Closure) {
+	defer Traceback("Q.put", args)
+	for _, x := range args {
+		Q.add(x)
+	}
+	return Return(Q)
+}
+
+// Q.get() removes and returns the first value of priority queue Q.
+// It fails if Q is empty.  Q.get() is equivalent to @Q.
+func (Q *VPQueue) Get(args ...Value) (Value, *Closure) {
+	defer Traceback("Q.get", args)
+	return Return(Q.first(true))
+}
+
+// Q.peek() returns the first value of priority queue Q without removing it.
+// It fails if Q is empty.
+func (Q *VPQueue) Peek(args ...Value) (Value, *Closure) {
+	defer Traceback("Q.peek", args)
+	return Return(Q.first(false))
+}
@@ -0,0 +1,258 @@
+//  fscanner.go -- string scanning methods
+//
+//  The analysis methods (upto, many, any, match, find, bal, pos) produce
+//  positions in the subject without changing the scanner.  The matching
+//  methods (tab, move) change the position and produce the characters
+//  passed over; if resumed, they restore the previous position and fail,
+//  so that goal-directed evaluation backtracks through a scan.
+//
+//  Character set arguments may be csets or strings.
+
+package runtime
+
+// Declare methods
+var ScannerMethods = MethodTable([]*VProcedure{
+	DefMeth((*VScanner).Subject, "subject", "", "return subject string"),
+	DefMeth((*VScanner).Pos, "pos", "i", "test or return scanning position"),
+	DefMeth((*VScanner).Tab, "tab", "i", "move to position"),
+	DefMeth((*VScanner).Move, "move", "i", "move by i characters"),
+	DefMeth((*VScanner).Upto, "upto", "c", "find positions of characters"),
+	DefMeth((*VScanner).Many, "many", "c", "match run of characters"),
+	DefMeth((*VScanner).Any, "any", "c", "match one character"),
+	DefMeth((*VScanner).Match, "match", "s", "match string"),
+	DefMeth((*VScanner).Find, "find", "s", "find positions of string"),
+	DefMeth((*VScanner).Bal, "bal", "c1,c2,c3", "find balanced positions"),
+})
+
+// Y.subject() returns the subject string of scanner Y.
+func (y *VScanner) Subject(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.subject", args)
+	return Return(y.subject)
+}
+
+// Y.pos(i) returns the current position of scanner Y if i is omitted.
+// Otherwise it returns the current position if that is i,
+// where i may be nonpositive to count back from the end,
+// and fails if it is not.
+func (y *VScanner) Pos(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.pos", args)
+	if len(args) > 0 && args[0] != NilValue {
+		if GoIndex(IntVal(args[0]), len(y.runes)) != y.pos {
+			return Fail()
+		}
+	}
+	return Return(NewNumber(float64(y.pos + 1)))
+}
+
+// Y.tab(i) moves the position of scanner Y to i and returns the
+// substring passed over.  It fails if i is out of range.
+// If resumed, it restores the previous position and fails.
+func (y *VScanner) Tab(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.tab", args)
+	i := GoIndex(IntVal(ProcArg(args, 0, ZERO)), len(y.runes))
+	return y.moveTo(i)
+}
+
+// Y.move(i) moves the position of scanner Y by i characters,
+// which may be negative, and returns the substring passed over.
+// It fails if the new position is out of range.
+// If resumed, it restores the previous position and fails.
+func (y *VScanner) Move(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.move", args)
+	return y.moveTo(y.pos + IntVal(ProcArg(args, 0, NilValue)))
+}
+
+// VScanner.moveTo(i) implements tab() and move() given a Go index i
+func (y *VScanner) moveTo(i int) (Value, *Closure) {
+	if i < 0 || i > len(y.runes) {
+		return Fail()
+	}
+	old := y.pos
+	y.pos = i
+	var s Value
+	if old <= i {
+		s = y.subject.slice(nil, old, i)
+	} else {
+		s = y.subject.slice(nil, i, old)
+	}
+	return s, &Closure{func() (Value, *Closure) {
+		y.pos = old // restore position on backtracking
+		return Fail()
+	}}
+}
+
+// Y.upto(c) generates in increasing order the positions in the subject
+// of scanner Y, starting at its current position, of any of the
+// characters of c.
+func (y *VScanner) Upto(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.upto", args)
+	c := charset(ProcArg(args, 0, NilValue))
+	r := y.runes
+	return positions(y.pos, func(k int) int {
+		return scanUpto(r, c, k, len(r))
+	})
+}
+
+// Y.many(c) returns the position in the subject of scanner Y
+// following the longest run of characters of c beginning at the
+// current position.  It fails if the current character is not in c.
+func (y *VScanner) Many(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.many", args)
+	c := charset(ProcArg(args, 0, NilValue))
+	return position(scanMany(y.runes, c, y.pos, len(y.runes)))
+}
+
+// Y.any(c) returns the position in the subject of scanner Y following
+// the current character if that is one of the characters of c,
+// and fails otherwise.
+func (y *VScanner) Any(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.any", args)
+	c := charset(ProcArg(args, 0, NilValue))
+	return position(scanAny(y.runes, c, y.pos, len(y.runes)))
+}
+
+// Y.match(s) returns the position in the subject of scanner Y
+// following string s if s occurs at the current position,
+// and fails otherwise.
+func (y *VScanner) Match(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.match", args)
+	t := ToString(ProcArg(args, 0, NilValue)).ToRunes()
+	return position(scanMatch(y.runes, t, y.pos, len(y.runes)))
+}
+
+// Y.find(s) generates in increasing order the positions in the subject
+// of scanner Y, starting at its current position, where string s occurs.
+func (y *VScanner) Find(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.find", args)
+	t := ToString(ProcArg(args, 0, NilValue)).ToRunes()
+	r := y.runes
+	return positions(y.pos, func(k int) int {
+		return scanFind(r, t, k, len(r))
+	})
+}
+
+// Y.bal(c1, c2, c3) generates in increasing order the positions in the
+// subject of scanner Y, starting at its current position, of characters
+// of c1 that are balanced with respect to the opening characters of c2
+// and the closing characters of c3.  If c1 is omitted, any character
+// qualifies; c2 and c3 default to "(" and ")".
+func (y *VScanner) Bal(args ...Value) (Value, *Closure) {
+	defer Traceback("Y.bal", args)
+	return scanBal(y.runes, args, y.pos, len(y.runes))
+}
+
+//  -------------------------- scanning helpers ---------------------
+//  These operate on a slice of runes r within the Go index bounds
+//  i and j, and return a Go index or -1 for failure.
+
+// charset(x) returns a membership test for cset or string x
+func charset(x Value) func(rune) bool {
+	return CsetVal(x).Has
+}
+
+// scanUpto(r, c, i, j) returns the index of the first character of c
+func scanUpto(r []rune, c func(rune) bool, i int, j int) int {
+	for k := i; k < j; k++ {
+		if c(r[k]) {
+			return k
+		}
+	}
+	return -1
+}
+
+// scanMany(r, c, i, j) returns the index following a run of characters of c
+func scanMany(r []rune, c func(rune) bool, i int, j int) int {
+	if i >= j || !c(r[i]) {
+		return -1
+	}
+	for i < j && c(r[i]) {
+		i++
+	}
+	return i
+}
+
+// scanAny(r, c, i, j) returns the index following a character of c
+func scanAny(r []rune, c func(rune) bool, i int, j int) int {
+	if i >= j || !c(r[i]) {
+		return -1
+	}
+	return i + 1
+}
+
+// scanMatch(r, t, i, j) returns the index following string t
+func scanMatch(r []rune, t []rune, i int, j int) int {
+	if j-i < len(t) {
+		return -1
+	}
+	for k, c := range t {
+		if r[i+k] != c {
+			return -1
+		}
+	}
+	return i + len(t)
+}
+
+// scanFind(r, t, i, j) returns the index of the first occurrence of t
+func scanFind(r []rune, t []rune, i int, j int) int {
+	for k := i; k+len(t) <= j; k++ {
+		if scanMatch(r, t, k, j) >= 0 {
+			return k
+		}
+	}
+	return -1
+}
+
+// scanBal(r, args, i, j) generates balanced positions for bal(c1,c2,c3)
+func scanBal(r []rune, args []Value, i int, j int) (Value, *Closure) {
+	c1 := func(rune) bool { return true }
+	if x := ProcArg(args, 0, NilValue); x != NilValue {
+		c1 = charset(x)
+	}
+	c2 := charset(ProcArg(args, 1, NewString("(")))
+	c3 := charset(ProcArg(args, 2, NewString(")")))
+	depth := 0
+	return positions(i, func(k int) int {
+		for ; k < j && depth >= 0; k++ {
+			d := depth
+			depth += balance(r[k], c2, c3)
+			if d == 0 && c1(r[k]) {
+				return k
+			}
+		}
+		return -1
+	})
+}
+
+// balance(c, c2, c3) returns the change in nesting depth for character c
+func balance(c rune, c2 func(rune) bool, c3 func(rune) bool) int {
+	if c2(c) {
+		return 1
+	} else if c3(c) {
+		return -1
+	}
+	return 0
+}
+
+// position(k) returns Go index k as a Goaldi position, or fails if k < 0
+func position(k int) (Value, *Closure) {
+	if k < 0 {
+		return Fail()
+	}
+	return Return(NewNumber(float64(k + 1)))
+}
+
+// positions(i, next) generates Goaldi positions for the Go indices
+// returned by next(i), next(k+1), ... for each successive result k
+// until next returns -1
+func positions(i int, next func(int) int) (Value, *Closure) {
+	var f *Closure
+	f = &Closure{func() (Value, *Closure) {
+		k := next(i)
+		if k < 0 {
+			return Fail()
+		}
+		i = k + 1
+		return NewNumber(float64(k + 1)), f
+	}}
+	return f.Resume()
+}
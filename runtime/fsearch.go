@@ -0,0 +1,121 @@
+//  fsearch.go -- string searching procedures
+//
+//  These are the string analysis functions of Icon, applied to an
+//  explicit subject string s instead of to a scanning environment.
+//  Each examines the characters of s between positions i and j,
+//  which default to 1 and 0 (the whole string), and produces positions
+//  in s.  The generators find, upto, and bal suspend every position
+//  that qualifies, so that goal-directed evaluation can try each one.
+//  They fail if i or j is out of range.
+//
+//  Character set arguments may be csets or strings.
+
+package runtime
+
+// Declare procedures
+func init() {
+	DefLib(Find, "find", "s1,s2,i,j", "generate positions of s1 in s2")
+	DefLib(Upto, "upto", "c,s,i,j", "generate positions of characters")
+	DefLib(Match, "match", "s1,s2,i,j", "match initial string")
+	DefLib(Many, "many", "c,s,i,j", "match run of characters")
+	DefLib(Any, "any", "c,s,i,j", "match one character")
+	DefLib(Bal, "bal", "c1,c2,c3,s,i,j", "generate balanced positions")
+}
+
+// find(s1, s2, i, j) generates in increasing order the positions in s2
+// between i and j at which s1 occurs as a substring.
+func Find(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("find", args)
+	t := ToString(ProcArg(args, 0, NilValue)).ToRunes()
+	r, i, j, ok := subject(args, 1)
+	if !ok {
+		return Fail()
+	}
+	return positions(i, func(k int) int {
+		return scanFind(r, t, k, j)
+	})
+}
+
+// upto(c, s, i, j) generates in increasing order the positions in s
+// between i and j of any of the characters of c.
+func Upto(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("upto", args)
+	c := charset(ProcArg(args, 0, NilValue))
+	r, i, j, ok := subject(args, 1)
+	if !ok {
+		return Fail()
+	}
+	return positions(i, func(k int) int {
+		return scanUpto(r, c, k, j)
+	})
+}
+
+// match(s1, s2, i, j) returns the position in s2 following s1
+// if s1 occurs at position i, and fails otherwise.
+func Match(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("match", args)
+	t := ToString(ProcArg(args, 0, NilValue)).ToRunes()
+	r, i, j, ok := subject(args, 1)
+	if !ok {
+		return Fail()
+	}
+	return position(scanMatch(r, t, i, j))
+}
+
+// many(c, s, i, j) returns the position in s following the longest
+// run of characters of c beginning at position i.
+// It fails if the character at i is not in c.
+func Many(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("many", args)
+	c := charset(ProcArg(args, 0, NilValue))
+	r, i, j, ok := subject(args, 1)
+	if !ok {
+		return Fail()
+	}
+	return position(scanMany(r, c, i, j))
+}
+
+// any(c, s, i, j) returns i+1 if the character of s at position i
+// is in c, and fails otherwise.
+func Any(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("any", args)
+	c := charset(ProcArg(args, 0, NilValue))
+	r, i, j, ok := subject(args, 1)
+	if !ok {
+		return Fail()
+	}
+	return position(scanAny(r, c, i, j))
+}
+
+// bal(c1, c2, c3, s, i, j) generates in increasing order the positions
+// in s between i and j of characters of c1 that are balanced with respect
+// to the opening characters of c2 and the closing characters of c3.
+// If c1 is omitted, any character qualifies; c2 and c3 default to
+// "(" and ")".
+func Bal(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("bal", args)
+	r, i, j, ok := subject(args, 3)
+	if !ok {
+		return Fail()
+	}
+	if len(args) > 3 {
+		args = args[:3]
+	}
+	return scanBal(r, args, i, j)
+}
+
+// subject(args, n) returns the subject string args[n] as runes along
+// with the Go indices of the positions args[n+1] and args[n+2],
+// in increasing order, and reports whether those positions are valid.
+func subject(args []Value, n int) ([]rune, int, int, bool) {
+	r := ToString(ProcArg(args, n, NilValue)).ToRunes()
+	i := GoIndex(IntVal(ProcArg(args, n+1, ONE)), len(r))
+	j := GoIndex(IntVal(ProcArg(args, n+2, ZERO)), len(r))
+	if i > len(r) || j > len(r) {
+		return r, i, j, false
+	}
+	if i > j {
+		i, j = j, i
+	}
+	return r, i, j, true
+}
@@ -0,0 +1,529 @@
+//  fserial.go -- binary serialization of values
+//
+//  A serialized value begins with the header "goaldi" and a version byte,
+//  followed by the value encoded as a one-byte tag and its contents.
+//  Integers are written as varints and strings with a length prefix.
+//
+//  Lists, sets, tables, records, and record types are numbered in order
+//  of first appearance; each later appearance is written as a reference
+//  to that number, preserving sharing and allowing cycles.  Record types
+//  are written by qualified name, with their field names, and are looked
+//  up again when the value is read.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+)
+
+// Declare procedures
+func init() {
+	DefLib(Serialize, "serialize", "x,f", "write value in binary form")
+	DefLib(Deserialize, "deserialize", "f", "read value in binary form")
+}
+
+// serialization header and version
+const serHeader = "goaldi"
+const serVersion = 1
+
+// serialization tags
+const (
+	serNil      = 'n' // nil
+	serNumber   = 'f' // number: 8-byte IEEE float
+	serBigInt   = 'i' // big integer: decimal digits
+	serRational = 'q' // rational: "n/d"
+	serDecimal  = 'd' // decimal: unscaled digits, scale
+	serComplex  = 'z' // complex: two 8-byte floats
+	serString   = 's' // string: UTF-8
+	serBytes    = 'b' // bytes
+	serCset     = 'a' // cset: count, then lo and hi of each range
+	serType     = 't' // standard type: name
+	serCtor     = 'R' // record constructor: name, count, field names
+	serList     = 'L' // list: count, elements
+	serSet      = 'S' // set: count, elements
+	serTable    = 'T' // table: default, count, keys and values
+	serValueSet = 'U' // set keyed by value: count, elements
+	serValueTab = 'V' // table keyed by value: default, count, keys, values
+	serOrderTab = 'O' // ordered table: default, count, keys and values
+	serOrderVal = 'W' // ordered table keyed by value: as above
+	serSortMap  = 'M' // sorted map: default, count, keys and values
+	serRecord   = 'r' // record: constructor, values
+	serRef      = '^' // reference to earlier structure: number
+)
+
+// serialize(x, f) writes x to file f in a binary form that can be read
+// by deserialize(), and returns f.
+// If f is omitted, serialize returns a bytes value instead.
+// Lists, sets, tables, sorted maps, and records are written with their
+// contents, preserving shared references and cycles.
+// Record types are identified by name and must be declared identically
+// when the value is read.
+// An exception is thrown for values such as procedures, files,
+// and external Go values, which cannot be serialized.
+func Serialize(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("serialize", args)
+	x := ProcArg(args, 0, NilValue)
+	w := &serWriter{ids: make(map[Value]int)}
+	w.b.WriteString(serHeader)
+	w.b.WriteByte(serVersion)
+	w.value(x)
+	f := ProcArg(args, 1, NilValue)
+	if f == NilValue {
+		return Return(&VBytes{w.b.Bytes(), nil})
+	}
+	ff := f.(*VFile)
+	if ff.Writer == nil {
+		panic(NewErr(ErrNotWriter, f))
+	}
+	if _, err := ff.Writer.Write(w.b.Bytes()); err != nil {
+		panic(err)
+	}
+	return Return(f)
+}
+
+// serWriter holds the state of serialization
+type serWriter struct {
+	b   bytes.Buffer  // output
+	ids map[Value]int // structure numbers
+}
+
+// serWriter.uint(n) writes an unsigned varint
+func (w *serWriter) uint(n uint64) {
+	var a [binary.MaxVarintLen64]byte
+	w.b.Write(a[:binary.PutUvarint(a[:], n)])
+}
+
+// serWriter.float(f) writes an 8-byte float
+func (w *serWriter) float(f float64) {
+	var a [8]byte
+	binary.BigEndian.PutUint64(a[:], math.Float64bits(f))
+	w.b.Write(a[:])
+}
+
+// serWriter.str(s) writes a length-prefixed string
+func (w *serWriter) str(s string) {
+	w.uint(uint64(len(s)))
+	w.b.WriteString(s)
+}
+
+// serWriter.value(x) writes the encoding of x
+func (w *serWriter) value(x Value) {
+	if id, ok := w.ids[x]; ok {
+		w.b.WriteByte(serRef)
+		w.uint(uint64(id))
+		return
+	}
+	switch v := x.(type) {
+	case *vnil:
+		w.b.WriteByte(serNil)
+	case *VNumber:
+		w.b.WriteByte(serNumber)
+		w.float(v.Val())
+	case *VBigInt:
+		w.b.WriteByte(serBigInt)
+		w.str(v.Int().String())
+	case *VRational:
+		w.b.WriteByte(serRational)
+		w.str(v.Rat().String())
+	case *VDecimal:
+		w.b.WriteByte(serDecimal)
+		w.str(v.unscaled.String())
+		w.uint(uint64(v.scale))
+	case *VComplex:
+		w.b.WriteByte(serComplex)
+		w.float(real(v.Val()))
+		w.float(imag(v.Val()))
+	case *VString:
+		w.b.WriteByte(serString)
+		w.str(v.ToUTF8())
+	case *VBytes:
+		w.b.WriteByte(serBytes)
+		w.str(string(v.b))
+	case *VCset:
+		w.b.WriteByte(serCset)
+		w.uint(uint64(len(v.r)))
+		for _, r := range v.r {
+			w.uint(uint64(r.lo))
+			w.uint(uint64(r.hi))
+		}
+	case *VType:
+		if StdLib[v.TypeName] != v {
+			panic(NewErr(ErrSerial, x))
+		}
+		w.b.WriteByte(serType)
+		w.str(v.TypeName)
+	case *VCtor:
+		name := ctorName(v)
+		if name == "" {
+			panic(NewErr(ErrSerial, x))
+		}
+		w.ids[x] = len(w.ids)
+		w.b.WriteByte(serCtor)
+		w.str(name)
+		w.uint(uint64(len(v.Flist)))
+		for _, f := range v.Flist {
+			w.str(f)
+		}
+	case *VList:
+		w.ids[x] = len(w.ids)
+		w.b.WriteByte(serList)
+		n := len(v.data)
+		w.uint(uint64(n))
+		for i := 0; i < n; i++ {
+			w.value(v.Elem(i))
+		}
+	case *VSet:
+		w.ids[x] = len(w.ids)
+		if v.index != nil {
+			w.b.WriteByte(serValueSet)
+		} else {
+			w.b.WriteByte(serSet)
+		}
+		w.uint(uint64(len(v.members)))
+		for k := range v.members {
+			w.value(Import(k))
+		}
+	case *VTable:
+		w.ids[x] = len(w.ids)
+		switch {
+		case v.order != nil && v.index != nil:
+			w.b.WriteByte(serOrderVal)
+		case v.order != nil:
+			w.b.WriteByte(serOrderTab)
+		case v.index != nil:
+			w.b.WriteByte(serValueTab)
+		default:
+			w.b.WriteByte(serTable)
+		}
+		w.value(v.dfval)
+		w.uint(uint64(len(v.data)))
+		for _, k := range v.keys() {
+			w.value(Import(k))
+			w.value(v.data[k])
+		}
+	case *VSortMap:
+		w.ids[x] = len(w.ids)
+		w.b.WriteByte(serSortMap)
+		w.value(v.dfval)
+		w.uint(uint64(v.root.count()))
+		for _, n := range v.entries() {
+			w.value(n.key)
+			w.value(n.val)
+		}
+	case *VRecord:
+		w.b.WriteByte(serRecord)
+		w.value(v.Ctor)
+		w.ids[x] = len(w.ids)
+		for _, e := range v.Data {
+			w.value(e)
+		}
+	default:
+		t, _ := Type(nil, x)
+		panic(NewErrDetail(ErrSerial, t.(*VType).TypeName, x))
+	}
+}
+
+// ctorName(c) returns the qualified name under which record constructor c
+// was declared, "tuple" for a tuple type, or "" if c cannot be found
+func ctorName(c *VCtor) string {
+	if c.TypeName == "tuple" && TupleType(c.Flist) == c {
+		return "tuple"
+	}
+	for s := range AllSpaces() {
+		ns := allSpaces[s]
+		if Deref(ns.Get(c.TypeName)) == c {
+			return ns.GetQual() + c.TypeName
+		}
+	}
+	if StdLib[c.TypeName] == c {
+		return c.TypeName
+	}
+	return ""
+}
+
+// deserialize(f) reads a value written by serialize() from file f,
+// reconstructing its structures with their sharing and cycles.
+// It fails at end of file.
+// f may also be a bytes value produced by serialize().
+// An exception is thrown if the data is invalid or a record type
+// is not declared with the same fields; its message gives the byte
+// offset of the error, counting from 1.
+func Deserialize(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("deserialize", args)
+	f := ProcArg(args, 0, NilValue)
+	r := &serReader{}
+	switch v := f.(type) {
+	case *VBytes:
+		r.r = bytes.NewReader(v.b)
+	case *VFile:
+		if v.Reader == nil {
+			panic(NewErr(ErrNotReader, f))
+		}
+		r.r = v.Reader
+	default:
+		panic(NewErr(ErrBytes, f))
+	}
+	if br, ok := r.r.(io.ByteReader); ok {
+		r.br = br
+	}
+	if _, err := r.ReadByte(); err == io.EOF {
+		return Fail() // nothing more to read
+	} else if err != nil {
+		panic(err)
+	}
+	for i := 1; i < len(serHeader); i++ {
+		if r.byte() != serHeader[i] {
+			r.n = 0
+			r.fail("bad header")
+		}
+	}
+	if v := r.byte(); v != serVersion {
+		r.n--
+		r.fail(fmt.Sprintf("unsupported version %d", v))
+	}
+	x := r.value()
+	if _, ok := f.(*VBytes); ok && r.r.(*bytes.Reader).Len() > 0 {
+		r.fail("data after value")
+	}
+	return Return(x)
+}
+
+// serReader holds the state of deserialization
+type serReader struct {
+	r    io.Reader     // input
+	br   io.ByteReader // input, if it provides ReadByte
+	n    int64         // bytes read so far
+	refs []Value       // structures read so far
+}
+
+// serReader.fail(msg) throws an exception for an error at the current offset
+func (r *serReader) fail(msg string) {
+	panic(NewErrDetail(ErrDeserial, fmt.Sprintf("offset %d: %s", r.n+1, msg)))
+}
+
+// serReader.ReadByte reads a single byte without reading ahead
+func (r *serReader) ReadByte() (byte, error) {
+	var b byte
+	var err error
+	if r.br != nil {
+		b, err = r.br.ReadByte()
+	} else {
+		var a [1]byte
+		_, err = io.ReadFull(r.r, a[:])
+		b = a[0]
+	}
+	if err == nil {
+		r.n++
+	}
+	return b, err
+}
+
+// serReader.byte() reads a byte, failing at end of input
+func (r *serReader) byte() byte {
+	b, err := r.ReadByte()
+	if err == io.EOF {
+		r.fail("unexpected end of data")
+	} else if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// serReader.bytes(n) reads n bytes
+func (r *serReader) bytes(n int) []byte {
+	b := make([]byte, 0, prealloc(n))
+	for ; n > 0; n-- {
+		b = append(b, r.byte())
+	}
+	return b
+}
+
+// serReader.uint() reads an unsigned varint
+func (r *serReader) uint() uint64 {
+	n, err := binary.ReadUvarint(r)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		r.fail("unexpected end of data")
+	} else if err != nil {
+		r.fail("bad integer")
+	}
+	return n
+}
+
+// prealloc(n) limits the capacity allocated for n items to be read,
+// so that a forged count fails at end of data instead of exhausting memory
+func prealloc(n int) int {
+	const limit = 1024
+	if n > limit {
+		return limit
+	}
+	return n
+}
+
+// serReader.count() reads a count, checking that it is plausible
+func (r *serReader) count() int {
+	n := r.uint()
+	if n > math.MaxInt32 {
+		r.fail("bad count")
+	}
+	return int(n)
+}
+
+// serReader.float() reads an 8-byte float
+func (r *serReader) float() float64 {
+	return math.Float64frombits(binary.BigEndian.Uint64(r.bytes(8)))
+}
+
+// serReader.str() reads a length-prefixed string
+func (r *serReader) str() string {
+	return string(r.bytes(r.count()))
+}
+
+// serReader.value() reads and returns a value
+func (r *serReader) value() Value {
+	switch tag := r.byte(); tag {
+	case serNil:
+		return NilValue
+	case serNumber:
+		return NewNumber(r.float())
+	case serBigInt:
+		if z, ok := new(big.Int).SetString(r.str(), 10); ok {
+			return NewInteger(z)
+		}
+		r.fail("bad integer")
+	case serRational:
+		if q, ok := new(big.Rat).SetString(r.str()); ok {
+			return NewRational(q)
+		}
+		r.fail("bad rational")
+	case serDecimal:
+		z, ok := new(big.Int).SetString(r.str(), 10)
+		s := r.count()
+		if !ok {
+			r.fail("bad decimal")
+		}
+		return &VDecimal{z, s}
+	case serComplex:
+		re := r.float()
+		return NewComplex(complex(re, r.float()))
+	case serString:
+		return NewString(r.str())
+	case serBytes:
+		return &VBytes{[]byte(r.str()), nil}
+	case serCset:
+		n := r.count()
+		c := make([]crange, 0, prealloc(n))
+		for ; n > 0; n-- {
+			lo := rune(r.uint())
+			c = append(c, crange{lo, rune(r.uint())})
+		}
+		return normalize(c)
+	case serType:
+		name := r.str()
+		if t, ok := StdLib[name].(*VType); ok {
+			return t
+		}
+		r.fail("unknown type " + name)
+	case serCtor:
+		c := r.ctor()
+		r.refs = append(r.refs, c)
+		return c
+	case serList:
+		n := r.count()
+		L := InitList(make([]Value, 0, prealloc(n)))
+		r.refs = append(r.refs, L)
+		for ; n > 0; n-- {
+			L.data = append(L.data, r.value())
+		}
+		return L
+	case serSet, serValueSet:
+		S := NewSet(EMPTYLIST)
+		if tag == serValueSet {
+			S = NewValueSet(EMPTYLIST)
+		}
+		r.refs = append(r.refs, S)
+		for n := r.count(); n > 0; n-- {
+			S.add(r.value())
+		}
+		return S
+	case serTable, serValueTab, serOrderTab, serOrderVal:
+		T := NewTable(NilValue)
+		if tag == serValueTab || tag == serOrderVal {
+			T = NewValueTable(NilValue)
+		}
+		if tag == serOrderTab || tag == serOrderVal {
+			T.order = newKeyOrder()
+		}
+		r.refs = append(r.refs, T)
+		T.dfval = r.value()
+		for n := r.count(); n > 0; n-- {
+			k := r.value()
+			T.put(k, r.value())
+		}
+		return T
+	case serSortMap:
+		M := NewSortMap(NilValue)
+		r.refs = append(r.refs, M)
+		M.dfval = r.value()
+		for n := r.count(); n > 0; n-- {
+			k := r.value()
+			M.put(k, r.value())
+		}
+		return M
+	case serRecord:
+		posn := r.n
+		c, ok := r.value().(*VCtor)
+		if !ok {
+			r.n = posn
+			r.fail("record type expected")
+		}
+		R := c.New(nil)
+		r.refs = append(r.refs, R)
+		for i := range R.Data {
+			R.Data[i] = r.value()
+		}
+		return R
+	case serRef:
+		n := r.uint()
+		if n >= uint64(len(r.refs)) {
+			r.fail("bad reference")
+		}
+		return r.refs[n]
+	default:
+		r.n--
+		r.fail(fmt.Sprintf("bad tag %q", tag))
+	}
+	return nil // not reached
+}
+
+// serReader.ctor() reads a record constructor, finds it by name,
+// and checks that it has the expected fields
+func (r *serReader) ctor() *VCtor {
+	posn := r.n
+	name := r.str()
+	n := r.count()
+	fields := make([]string, 0, prealloc(n))
+	for ; n > 0; n-- {
+		fields = append(fields, r.str())
+	}
+	var c *VCtor
+	if name == "tuple" {
+		c = TupleType(fields)
+	} else if c = findCtor(name); c == nil {
+		r.n = posn
+		r.fail("unknown record type " + name)
+	}
+	ok := len(c.Flist) == len(fields)
+	for i := 0; ok && i < len(fields); i++ {
+		ok = c.Flist[i] == fields[i]
+	}
+	if !ok {
+		r.n = posn
+		r.fail("fields differ for record type " + name)
+	}
+	return c
+}
@@ -13,13 +13,20 @@ var SetMethods = MethodTable([]*VProcedure{
 	DefMeth((*VSet).Put, "put", "x[]", "add members"),
 	DefMeth((*VSet).Delete, "delete", "x[]", "remove members"),
 	DefMeth((*VSet).Member, "member", "x", "test membership"),
-	DefMeth((*VSet).Sort, "sort", "i", "produce sorted list"),
+	DefMeth(SetSort, "sort", "i,key,desc", "produce sorted list"),
 })
 
-// set(L) creates a set initialized by the values of list L.
+// set(L, byvalue) creates a set initialized by the values of list L.
+// If byvalue is supplied and not nil, lists, sets, tables, and records
+// are members by value, so that an equal structure is treated as the
+// same member.  Such a member is copied when added, so that later changes
+// to the value added do not affect the set.
 func Set(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("set", args)
 	L := ProcArg(args, 0, EMPTYLIST).(*VList)
+	if ProcArg(args, 1, NilValue) != NilValue {
+		return Return(NewValueSet(L))
+	}
 	return Return(NewSet(L))
 }
 
@@ -30,7 +37,7 @@ var EMPTYLIST = NewList(0, nil)
 func (S *VSet) Member(args ...Value) (Value, *Closure) {
 	defer Traceback("S.member", args)
 	x := ProcArg(args, 0, NilValue)
-	if (*S)[GoKey(x)] {
+	if S.has(x) {
 		return Return(x)
 	} else {
 		return Fail()
@@ -42,7 +49,7 @@ func (S *VSet) Member(args ...Value) (Value, *Closure) {
 func (S *VSet) Put(args ...Value) (Value, *Closure) {
 	defer Traceback("S.put", args)
 	for _, x := range args {
-		(*S)[GoKey(x)] = true
+		S.add(x)
 	}
 	return Return(S)
 }
@@ -52,19 +59,23 @@ func (S *VSet) Put(args ...Value) (Value, *Closure) {
 func (S *VSet) Delete(args ...Value) (Value, *Closure) {
 	defer Traceback("S.delete", args)
 	for _, x := range args {
-		delete(*S, GoKey(x))
+		S.remove(x)
 	}
 	return Return(S)
 }
 
-// S.sort(i) returns a sorted list of the members of set S.
-// This is equivalent to [:!S:].sort(i).
-func (S *VSet) Sort(args ...Value) (Value, *Closure) {
+// S.sort(i, key, desc) returns a sorted list of the members of set S.
+// This is equivalent to [:!S:].sort(i, key, desc).
+func SetSort(env *Env, S *VSet, args ...Value) (Value, *Closure) {
 	defer Traceback("S.sort", args)
-	i := ProcArg(args, 0, ONE).(Numerable).ToNumber()
-	members := make([]Value, 0, len(*S))
-	for k := range *S {
+	members := make([]Value, 0, len(S.members))
+	for k := range S.members {
 		members = append(members, Import(k)) // convert back from GoKey form
 	}
-	return InitList(members).Sort(i)
+	return Return(InitList(sortValues(env, members, args)))
+}
+
+// VSet.Sort(i, key, desc) sorts S outside any calling environment
+func (S *VSet) Sort(args ...Value) (Value, *Closure) {
+	return SetSort(nil, S, args...)
 }
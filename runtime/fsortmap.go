@@ -0,0 +1,146 @@
+//  fsortmap.go -- sorted map functions and methods
+
+package runtime
+
+// Declare methods
+var SortMapMethods = MethodTable([]*VProcedure{
+	DefMeth((*VSortMap).Member, "member", "x", "test membership"),
+	DefMeth((*VSortMap).Delete, "delete", "x[]", "remove entries"),
+	DefMeth(SortMapSort, "sort", "i,key,desc", "produce sorted list"),
+	DefMeth((*VSortMap).Ascend, "ascend", "lo,hi", "generate entries upward"),
+	DefMeth((*VSortMap).Descend, "descend", "hi,lo", "generate entries downward"),
+	DefMeth((*VSortMap).Floor, "floor", "x", "find entry at or below key"),
+	DefMeth((*VSortMap).Ceiling, "ceiling", "x", "find entry at or above key"),
+	DefMeth((*VSortMap).Min, "min", "", "find first entry"),
+	DefMeth((*VSortMap).Max, "max", "", "find last entry"),
+	DefMeth((*VSortMap).PopMin, "popmin", "", "remove first entry"),
+	DefMeth((*VSortMap).PopMax, "popmax", "", "remove last entry"),
+})
+
+// sortmap(x) creates a new, empty sorted map having x as the default value.
+// Keys are kept in the order used by L.sort(), and keys that sort
+// equally, such as 1 and decimal(1), are the same key.
+// Distinct structures are always distinct keys; those that sort equally,
+// such as any two sets, are kept in the order they were added.
+func SortMap(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("sortmap", args)
+	return Return(NewSortMap(ProcArg(args, 0, NilValue)))
+}
+
+// M.member(k) returns k if k is an existing key in sorted map M;
+// otherwise it fails.
+func (M *VSortMap) Member(args ...Value) (Value, *Closure) {
+	defer Traceback("M.member", args)
+	key := ProcArg(args, 0, NilValue)
+	if M.find(key) != nil {
+		return Return(key)
+	}
+	return Fail()
+}
+
+// M.delete(k...) deletes the entries with the given keys from sorted map M.
+// It returns M.
+func (M *VSortMap) Delete(args ...Value) (Value, *Closure) {
+	defer Traceback("M.delete", args)
+	for _, k := range args {
+		M.remove(k)
+	}
+	return Return(M)
+}
+
+// M.sort(i, key, desc) returns a sorted list of elemtype(key,value)
+// records holding the contents of sorted map M.
+// Sorting is by key if i=1 and by value if i=2;
+// entries that sort equally remain in key order.
+// Arguments are interpreted as for L.sort(i, key, desc).
+func SortMapSort(env *Env, M *VSortMap, args ...Value) (Value, *Closure) {
+	defer Traceback("M.sort", args)
+	l := make([]Value, 0, M.root.count())
+	for _, n := range M.entries() {
+		l = append(l, n.elem())
+	}
+	return Return(InitList(sortValues(env, l, args)))
+}
+
+// M.ascend(lo, hi) generates the entries of sorted map M, as
+// elemtype(key,value) records, in increasing order of key
+// from lo through hi inclusive.
+// If either bound is nil or omitted, that end of the range is unlimited.
+// !M is equivalent to M.ascend().
+func (M *VSortMap) Ascend(args ...Value) (Value, *Closure) {
+	defer Traceback("M.ascend", args)
+	return M.traverse(ProcArg(args, 0, NilValue), ProcArg(args, 1, NilValue), true)
+}
+
+// M.descend(hi, lo) generates the entries of sorted map M, as
+// elemtype(key,value) records, in decreasing order of key
+// from hi through lo inclusive.
+// If either bound is nil or omitted, that end of the range is unlimited.
+func (M *VSortMap) Descend(args ...Value) (Value, *Closure) {
+	defer Traceback("M.descend", args)
+	return M.traverse(ProcArg(args, 0, NilValue), ProcArg(args, 1, NilValue), false)
+}
+
+// M.floor(k) returns the entry of sorted map M having the greatest key
+// not after k, as an elemtype(key,value) record.
+// It fails if there is no such entry.
+func (M *VSortMap) Floor(args ...Value) (Value, *Closure) {
+	defer Traceback("M.floor", args)
+	k := ProcArg(args, 0, NilValue)
+	return smResult(M.seek(k, M.arrival(k), false, false))
+}
+
+// M.ceiling(k) returns the entry of sorted map M having the least key
+// not before k, as an elemtype(key,value) record.
+// It fails if there is no such entry.
+func (M *VSortMap) Ceiling(args ...Value) (Value, *Closure) {
+	defer Traceback("M.ceiling", args)
+	k := ProcArg(args, 0, NilValue)
+	return smResult(M.seek(k, M.arrival(k), true, false))
+}
+
+// M.min() returns the entry of sorted map M having the least key,
+// as an elemtype(key,value) record.  It fails if M is empty.
+func (M *VSortMap) Min(args ...Value) (Value, *Closure) {
+	defer Traceback("M.min", args)
+	return smResult(M.end(false))
+}
+
+// M.max() returns the entry of sorted map M having the greatest key,
+// as an elemtype(key,value) record.  It fails if M is empty.
+func (M *VSortMap) Max(args ...Value) (Value, *Closure) {
+	defer Traceback("M.max", args)
+	return smResult(M.end(true))
+}
+
+// M.popmin() removes and returns the entry of sorted map M having the
+// least key, as an elemtype(key,value) record.  It fails if M is empty.
+// M.popmin() is equivalent to @M.
+func (M *VSortMap) PopMin(args ...Value) (Value, *Closure) {
+	defer Traceback("M.popmin", args)
+	return M.pop(M.end(false))
+}
+
+// M.popmax() removes and returns the entry of sorted map M having the
+// greatest key, as an elemtype(key,value) record.  It fails if M is empty.
+func (M *VSortMap) PopMax(args ...Value) (Value, *Closure) {
+	defer Traceback("M.popmax", args)
+	return M.pop(M.end(true))
+}
+
+// VSortMap.pop(n) removes node n, if not nil, and returns its entry
+func (M *VSortMap) pop(n *smNode) (Value, *Closure) {
+	if n == nil {
+		return Fail()
+	}
+	M.remove(n.key)
+	return Return(n.elem())
+}
+
+// smResult(n) returns the entry of node n, or fails if n is nil
+func smResult(n *smNode) (Value, *Closure) {
+	if n == nil {
+		return Fail()
+	}
+	return Return(n.elem())
+}
@@ -15,11 +15,12 @@ func init() {
 	// Goaldi procedures
 	DefLib(Char, "char", "n", "return single character for Unicode value")
 	DefLib(Ord, "ord", "s", "return Unicode ordinal of single character")
-	DefLib(Reverse, "reverse", "s", "return mirror image of string")
-	DefLib(Left, "left", "s,w,p", "left-justify with padding p to width w")
-	DefLib(Center, "center", "s,w,p", "center with padding p to width w")
-	DefLib(Right, "right", "s,w,p", "right-justify with padding p to width w")
+	DefLib(Reverse, "reverse", "s,g", "return mirror image of string")
+	DefLib(Left, "left", "s,w,p,g", "left-justify with padding p to width w")
+	DefLib(Center, "center", "s,w,p,g", "center with padding p to width w")
+	DefLib(Right, "right", "s,w,p,g", "right-justify with padding p to width w")
 	DefLib(Unquote, "unquote", "s", "remove delimiters and escapes from s")
+	DefLib(Trim, "trim", "s,c", "remove leading and trailing characters")
 	// Go library functions
 	GoLib(strings.Contains, "contains", "s,substr", "return 1 if substr is in s")
 	GoLib(strings.ContainsAny, "containsany", "s,chars", "return 1 if any char is in s")
@@ -33,7 +34,6 @@ func init() {
 	GoLib(strings.Split, "split", "s,sep", "return fields delimited by sep")
 	GoLib(strings.ToUpper, "toupper", "s", "convert to upper case")
 	GoLib(strings.ToLower, "tolower", "s", "convert to lower case")
-	GoLib(strings.Trim, "trim", "s,cutset", "remove leading and trailing characters")
 }
 
 // string(x) returns a string representation of x.
@@ -51,7 +51,7 @@ func Char(env *Env, args ...Value) (Value, *Closure) {
 	var r [1]rune
 	i := IntVal(ProcArg(args, 0, NilValue))
 	if i < 0 || i > int(unicode.MaxRune) {
-		panic(NewExn("Character code out of range", args[0]))
+		panic(NewErr(ErrCharCode, args[0]))
 	}
 	r[0] = rune(i)
 	return Return(RuneString(r[:]))
@@ -62,19 +62,41 @@ func Ord(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("ord", args)
 	r := ToString(ProcArg(args, 0, NilValue)).ToRunes()
 	if len(r) != 1 {
-		panic(NewExn("String length not 1", args[0]))
+		panic(NewErr(ErrCharLen, args[0]))
 	}
 	return Return(NewNumber(float64(r[0])))
 }
 
-// left(s,w,p) left-justifies s in a string of width w, padding with p.
+// trim(s, c) removes from both ends of string s any characters in c,
+// which may be a cset or a string and defaults to " ".
+func Trim(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("trim", args)
+	s := ToString(ProcArg(args, 0, NilValue)).ToRunes()
+	c := CsetVal(ProcArg(args, 1, SPACE))
+	i := 0
+	j := len(s)
+	for i < j && c.Has(s[i]) {
+		i++
+	}
+	for j > i && c.Has(s[j-1]) {
+		j--
+	}
+	return Return(RuneString(s[i:j]))
+}
+
+// left(s,w,p,g) left-justifies s in a string of width w, padding with p.
+// If g is supplied and not nil, w is a display width in terminal columns,
+// and s and p are handled by grapheme clusters instead of by characters.
 func Left(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("left", args)
 	s := ToString(ProcArg(args, 0, NilValue)).ToRunes()
 	w := IntVal(ProcArg(args, 1, ONE))
 	p := ToString(ProcArg(args, 2, SPACE)).ToRunes()
 	if len(p) == 0 {
-		panic(NewExn("Empty padding string", args[2]))
+		panic(NewErr(ErrPadding, args[2]))
+	}
+	if ProcArg(args, 3, NilValue) != NilValue {
+		return Return(RuneString(justify(s, w, p, 'l')))
 	}
 	r := make([]rune, w)
 	copy(r, s)
@@ -86,14 +108,19 @@ func Left(env *Env, args ...Value) (Value, *Closure) {
 	return Return(RuneString(r))
 }
 
-// right(s,w,p) right-justifies s in a string of width w, padding with p.
+// right(s,w,p,g) right-justifies s in a string of width w, padding with p.
+// If g is supplied and not nil, w is a display width in terminal columns,
+// and s and p are handled by grapheme clusters instead of by characters.
 func Right(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("right", args)
 	s := ToString(ProcArg(args, 0, NilValue)).ToRunes()
 	w := IntVal(ProcArg(args, 1, ONE))
 	p := ToString(ProcArg(args, 2, SPACE)).ToRunes()
 	if len(p) == 0 {
-		panic(NewExn("Empty padding string", args[2]))
+		panic(NewErr(ErrPadding, args[2]))
+	}
+	if ProcArg(args, 3, NilValue) != NilValue {
+		return Return(RuneString(justify(s, w, p, 'r')))
 	}
 	n := w - len(s)
 	if n > 0 {
@@ -109,14 +136,19 @@ func Right(env *Env, args ...Value) (Value, *Closure) {
 	}
 }
 
-// center(s,w,p) centers s in a string of width w, padding with p.
+// center(s,w,p,g) centers s in a string of width w, padding with p.
+// If g is supplied and not nil, w is a display width in terminal columns,
+// and s and p are handled by grapheme clusters instead of by characters.
 func Center(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("center", args)
 	s := ToString(ProcArg(args, 0, NilValue)).ToRunes()
 	w := IntVal(ProcArg(args, 1, ONE))
 	p := ToString(ProcArg(args, 2, SPACE)).ToRunes()
 	if len(p) == 0 {
-		panic(NewExn("Empty padding string", args[2]))
+		panic(NewErr(ErrPadding, args[2]))
+	}
+	if ProcArg(args, 3, NilValue) != NilValue {
+		return Return(RuneString(justify(s, w, p, 'c')))
 	}
 	n := w - len(s) // amount of padding needed
 	if n > 0 {      // if any
@@ -139,10 +171,20 @@ func Center(env *Env, args ...Value) (Value, *Closure) {
 	}
 }
 
-// reverse(s) returns the end-for-end reversal of the string s.
+// reverse(s,g) returns the end-for-end reversal of the string s.
+// If g is supplied and not nil, s is reversed by grapheme clusters,
+// so that combining marks stay with the characters they modify.
 func Reverse(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("reverse", args)
 	r := ToString(ProcArg(args, 0, NilValue)).ToRunes()
+	if ProcArg(args, 1, NilValue) != NilValue {
+		b := graphemeBreaks(r)
+		g := make([]rune, 0, len(r))
+		for i := len(b) - 1; i > 0; i-- {
+			g = append(g, r[b[i-1]:b[i]]...)
+		}
+		return Return(RuneString(g))
+	}
 	n := len(r)
 	for i := 0; i < n/2; i++ {
 		r[i], r[n-1-i] = r[n-1-i], r[i]
@@ -19,6 +19,8 @@ const MMARGIN = 128 // extra margin to allow when growing the mapping table
 // the corresponding character of the "into" string.  If there is no
 // corresponding character, because "into" is shorter, then the character
 // from s is discarded.
+// Either "from" or "into" may be a cset, whose characters are taken in order.
+// The defaults map upper case letters to lower case.
 func Map(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("map", args)
 
@@ -27,7 +29,7 @@ func Map(env *Env, args ...Value) (Value, *Closure) {
 	from := ToString(ProcArg(args, 1, UCASE)).ToRunes()
 	into := ToString(ProcArg(args, 2, LCASE)).ToRunes()
 	if len(into) > len(from) {
-		panic(NewExn("Map: *into > *from", RuneString(into)))
+		panic(NewErr(ErrMapLen, RuneString(into)))
 	}
 
 	// build a mapping table ctable
@@ -13,14 +13,16 @@ var _ = fmt.Printf // enable debugging
 var TableMethods = MethodTable([]*VProcedure{
 	DefMeth((*VTable).Member, "member", "x", "test membership"),
 	DefMeth((*VTable).Delete, "delete", "x[]", "remove entries"),
-	DefMeth((*VTable).Sort, "sort", "i", "produce sorted list"),
+	DefMeth(TableSort, "sort", "i,key,desc", "produce sorted list"),
+	DefMeth((*VTable).ToFront, "tofront", "x", "move key to front"),
+	DefMeth((*VTable).ToBack, "toback", "x", "move key to back"),
 })
 
 // Declare methods on Go Tables
 var GoMapMethods = MethodTable([]*VProcedure{
 	DefMeth(GoMapMember, "member", "x", "test membership"),
 	DefMeth(GoMapDelete, "delete", "x[]", "remove entries"),
-	DefMeth(GoMapSort, "sort", "i", "produce sorted list"),
+	DefMeth(GoMapSort, "sort", "i,key,desc", "produce sorted list"),
 })
 
 // Declare elemtype record for generating table values
@@ -30,11 +32,26 @@ func init() {
 	StdLib["elemtype"] = ElemType
 }
 
-// table(x) creates a new, empty table having x as the default value.
+// table(x, byvalue, ordered) creates a new, empty table having x as the
+// default value.
+// If byvalue is supplied and not nil, list, set, table, and record keys
+// are compared by value, so that an equal structure indexes the same entry.
+// Such a key is copied when added, so that later changes to the value
+// used as the key do not affect the table.
+// If ordered is supplied and not nil, the table remembers the order in
+// which its keys were added, and !T, image(T), and jsonencode(T)
+// present the entries in that order.
 func Table(env *Env, args ...Value) (Value, *Closure) {
 	defer Traceback("table", args)
 	dfval := ProcArg(args, 0, NilValue)
-	return Return(NewTable(dfval))
+	T := NewTable(dfval)
+	if ProcArg(args, 1, NilValue) != NilValue {
+		T = NewValueTable(dfval)
+	}
+	if ProcArg(args, 2, NilValue) != NilValue {
+		T.order = newKeyOrder()
+	}
+	return Return(T)
 }
 
 // T.member(k) returns k if k is an existing key in table T;
@@ -70,18 +87,52 @@ func GoMapDelete(T Value, args ...Value) (Value, *Closure) {
 	return Return(T)
 }
 
-// T.sort(i) returns a sorted list of elemtype(key,value) records
-// holding the contents of table T.
+// T.sort(i, key, desc) returns a sorted list of elemtype(key,value)
+// records holding the contents of table T.
 // Sorting is by key if i=1 and by value if i=2.
-// T.sort(i) is equivalent to [:!T:].sort(i).
+// T.sort(i, key, desc) is equivalent to [:!T:].sort(i, key, desc),
+// so entries of an ordered table that sort equally remain in order.
+func TableSort(env *Env, T *VTable, args ...Value) (Value, *Closure) {
+	if T.order == nil {
+		return GoMapSort(env, T.data, args...)
+	}
+	defer Traceback("T.sort", args)
+	return Return(InitList(sortValues(env, T.entries(), args)))
+}
+
+// VTable.Sort(i, key, desc) sorts T outside any calling environment
 func (T *VTable) Sort(args ...Value) (Value, *Closure) {
-	return GoMapSort(T.data, args...)
+	return TableSort(nil, T, args...)
+}
+
+// T.tofront(k) moves key k to the front of ordered table T and returns T.
+// It fails if k is not a key in T.
+func (T *VTable) ToFront(args ...Value) (Value, *Closure) {
+	defer Traceback("T.tofront", args)
+	return T.move(ProcArg(args, 0, NilValue), true)
+}
+
+// T.toback(k) moves key k to the back of ordered table T and returns T.
+// It fails if k is not a key in T.
+func (T *VTable) ToBack(args ...Value) (Value, *Closure) {
+	defer Traceback("T.toback", args)
+	return T.move(ProcArg(args, 0, NilValue), false)
+}
+
+// VTable.move(k, front) implements T.tofront(k) and T.toback(k)
+func (T *VTable) move(k Value, front bool) (Value, *Closure) {
+	if T.order == nil {
+		panic(NewErr(ErrOrdered, T))
+	}
+	if T.order.move(T.index.lookup(k), front) {
+		return Return(T)
+	}
+	return Fail()
 }
 
-// GoMapSort(T, i) produces [:!T:].sort(i)
-func GoMapSort(T Value, args ...Value) (Value, *Closure) {
+// GoMapSort(env, T, i, key, desc) produces [:!T:].sort(i, key, desc)
+func GoMapSort(env *Env, T Value, args ...Value) (Value, *Closure) {
 	defer Traceback("T.sort", args)
-	p := ProcArg(args, 0, ONE)
 	mv := reflect.ValueOf(T)
 	klist := mv.MapKeys()
 	vlist := make([]Value, mv.Len())
@@ -90,5 +141,5 @@ func GoMapSort(T Value, args ...Value) (Value, *Closure) {
 		v := Import(mv.MapIndex(kv).Interface())
 		vlist[i] = ElemType.New([]Value{k, v})
 	}
-	return InitList(vlist).Sort(p)
+	return Return(InitList(sortValues(env, vlist, args)))
 }
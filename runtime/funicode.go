@@ -0,0 +1,495 @@
+//  funicode.go -- Unicode normalization, grapheme clusters, and widths
+//
+//  Goaldi strings are indexed by code point, so a character that is
+//  displayed as one symbol may occupy several positions: an accented
+//  letter in decomposed form, a flag, or an emoji sequence.  These
+//  procedures deal with such text.  The Unicode data tables are in
+//  unidata.go, which is generated by mkunidata.py; see doc/build.adoc.
+//  They may be of an older Unicode version than Go's unicode package.
+//
+//  A grapheme cluster is a sequence of characters that a reader perceives
+//  as a single character, as defined by Unicode Standard Annex #29.
+//  The display width of a string is the number of terminal columns
+//  it occupies:  East Asian wide characters and emoji take two columns,
+//  and combining marks and format characters take none.
+
+package runtime
+
+//go:generate sh -c "python3.9 mkunidata.py $UNICORE >unidata.new && gofmt unidata.new >unidata.go; rm -f unidata.new"
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Declare procedures
+func init() {
+	DefLib(Normalize, "normalize", "s,form", "normalize Unicode string")
+	DefLib(Graphemes, "graphemes", "s", "generate grapheme clusters")
+	DefLib(NGraphemes, "ngraphemes", "s", "count grapheme clusters")
+	DefLib(Width, "width", "s", "return display width")
+	DefLib(CaseFold, "casefold", "s", "fold case for comparison")
+}
+
+// normalize(s, form) returns the string s in Unicode normalization form
+// "NFC" (the default), "NFD", "NFKC", or "NFKD".
+// The composed forms NFC and NFKC are the most compact; the compatibility
+// forms NFKC and NFKD also replace characters such as ligatures and
+// full-width letters with their ordinary equivalents.
+func Normalize(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("normalize", args)
+	s := ToString(ProcArg(args, 0, NilValue))
+	f := ProcArg(args, 1, NewString("NFC"))
+	switch strings.ToUpper(ToString(f).ToUTF8()) {
+	case "NFC":
+		return Return(RuneString(compose(decompose(s.ToRunes(), false))))
+	case "NFD":
+		return Return(RuneString(decompose(s.ToRunes(), false)))
+	case "NFKC":
+		return Return(RuneString(compose(decompose(s.ToRunes(), true))))
+	case "NFKD":
+		return Return(RuneString(decompose(s.ToRunes(), true)))
+	default:
+		panic(NewErr(ErrNormForm, f))
+	}
+}
+
+// graphemes(s) generates the grapheme clusters of s, in order,
+// each as a string.
+func Graphemes(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("graphemes", args)
+	s := ToString(ProcArg(args, 0, NilValue))
+	b := graphemeBreaks(s.ToRunes())
+	i := 0
+	var f *Closure
+	f = &Closure{func() (Value, *Closure) {
+		i++
+		if i >= len(b) {
+			return Fail()
+		}
+		return s.substr(b[i-1], b[i]), f
+	}}
+	return f.Resume()
+}
+
+// ngraphemes(s) returns the number of grapheme clusters in s.
+func NGraphemes(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("ngraphemes", args)
+	s := ToString(ProcArg(args, 0, NilValue))
+	return Return(NewNumber(float64(len(graphemeBreaks(s.ToRunes())) - 1)))
+}
+
+// width(s) returns the number of terminal columns needed to display s.
+// Each grapheme cluster occupies zero, one, or two columns.
+func Width(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("width", args)
+	n := 0
+	for _, g := range cells(ToString(ProcArg(args, 0, NilValue)).ToRunes()) {
+		n += g.w
+	}
+	return Return(NewNumber(float64(n)))
+}
+
+// casefold(s) returns s with full Unicode case folding applied.
+// Two strings that differ only in case fold to the same string,
+// as do "Straße" and "STRASSE".
+func CaseFold(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("casefold", args)
+	s := ToString(ProcArg(args, 0, NilValue)).ToRunes()
+	r := make([]rune, 0, len(s))
+	for _, c := range s {
+		if f, ok := caseFold[c]; ok {
+			r = append(r, []rune(f)...)
+		} else {
+			r = append(r, c)
+		}
+	}
+	return Return(RuneString(r))
+}
+
+//  -------------------------- normalization ---------------------
+
+// Hangul syllables are composed and decomposed algorithmically.
+const (
+	hangulBase  = 0xAC00 // first syllable
+	hangulCount = 11172  // number of syllables
+	hangulL     = 0x1100 // first leading consonant
+	hangulV     = 0x1161 // first vowel
+	hangulT     = 0x11A7 // one before first trailing consonant
+	hangulNV    = 21     // number of vowels
+	hangulNT    = 28     // number of trailing consonants, plus one
+)
+
+// A cccRange gives the canonical combining class of a range of characters.
+type cccRange struct {
+	lo, hi rune
+	ccc    uint8
+}
+
+// combiningClass(c) returns the canonical combining class of c
+func combiningClass(c rune) uint8 {
+	i := sort.Search(len(cccTable), func(i int) bool {
+		return cccTable[i].hi >= c
+	})
+	if i < len(cccTable) && cccTable[i].lo <= c {
+		return cccTable[i].ccc
+	}
+	return 0
+}
+
+// decompose(s, compat) returns the canonical (or compatibility)
+// decomposition of s, with combining marks in canonical order
+func decompose(s []rune, compat bool) []rune {
+	r := make([]rune, 0, len(s))
+	var expand func(c rune)
+	expand = func(c rune) {
+		if c >= hangulBase && c < hangulBase+hangulCount {
+			k := c - hangulBase
+			r = append(r, hangulL+k/(hangulNV*hangulNT),
+				hangulV+(k%(hangulNV*hangulNT))/hangulNT)
+			if t := k % hangulNT; t != 0 {
+				r = append(r, hangulT+t)
+			}
+		} else if d, ok := canonDecomp[c]; ok {
+			for _, x := range d {
+				expand(x)
+			}
+		} else if d, ok := compatDecomp[c]; ok && compat {
+			for _, x := range d {
+				expand(x)
+			}
+		} else {
+			r = append(r, c)
+		}
+	}
+	for _, c := range s {
+		expand(c)
+	}
+	// put each run of combining marks in order by combining class
+	for i := 0; i < len(r); {
+		j := i
+		for j < len(r) && combiningClass(r[j]) != 0 {
+			j++
+		}
+		if j-i > 1 {
+			m := r[i:j]
+			sort.SliceStable(m, func(a, b int) bool {
+				return combiningClass(m[a]) < combiningClass(m[b])
+			})
+		}
+		i = j + 1
+	}
+	return r
+}
+
+// composePairs maps pairs of characters to their canonical composition.
+var composePairs = make(map[[2]rune]rune)
+
+func init() {
+	excluded := make(map[rune]bool)
+	for _, c := range compExclusions {
+		excluded[c] = true
+	}
+	for c, d := range canonDecomp {
+		r := []rune(d)
+		if len(r) == 2 && !excluded[c] &&
+			combiningClass(c) == 0 && combiningClass(r[0]) == 0 {
+			composePairs[[2]rune{r[0], r[1]}] = c
+		}
+	}
+}
+
+// composite(a, b) returns the canonical composition of a and b, if any
+func composite(a rune, b rune) (rune, bool) {
+	if a >= hangulL && a < hangulL+19 && b >= hangulV && b < hangulV+hangulNV {
+		return hangulBase + ((a-hangulL)*hangulNV+(b-hangulV))*hangulNT, true
+	}
+	if a >= hangulBase && a < hangulBase+hangulCount &&
+		(a-hangulBase)%hangulNT == 0 && b > hangulT && b < hangulT+hangulNT {
+		return a + (b - hangulT), true
+	}
+	c, ok := composePairs[[2]rune{a, b}]
+	return c, ok
+}
+
+// compose(d) returns the canonical composition of decomposed string d
+func compose(d []rune) []rune {
+	r := make([]rune, 0, len(d))
+	starter := -1    // index in r of last starter
+	last := uint8(0) // combining class of last character appended
+	for _, c := range d {
+		k := combiningClass(c)
+		if starter >= 0 && (last < k || (last == 0 && starter == len(r)-1)) {
+			if x, ok := composite(r[starter], c); ok {
+				r[starter] = x
+				continue
+			}
+		}
+		if k == 0 {
+			starter = len(r)
+		}
+		last = k
+		r = append(r, c)
+	}
+	return r
+}
+
+//  -------------------------- grapheme clusters ---------------------
+
+// grapheme cluster break categories
+const (
+	gcOther = iota
+	gcCR
+	gcLF
+	gcControl
+	gcExtend
+	gcZWJ
+	gcRegional
+	gcPrepend
+	gcSpacingMark
+	gcL
+	gcV
+	gcT
+	gcLV
+	gcLVT
+	gcPictographic
+)
+
+// gcbProperty(c) returns the grapheme cluster break category of c
+func gcbProperty(c rune) int {
+	switch {
+	case c == '\r':
+		return gcCR
+	case c == '\n':
+		return gcLF
+	case c == 0x200D:
+		return gcZWJ
+	case c >= hangulBase && c < hangulBase+hangulCount:
+		if (c-hangulBase)%hangulNT == 0 {
+			return gcLV
+		}
+		return gcLVT
+	case c >= 0x20 && c < 0x7F:
+		return gcOther // fast path for ASCII
+	case unicode.Is(gcbExtend, c):
+		return gcExtend
+	case unicode.Is(gcbControl, c):
+		return gcControl
+	case unicode.Is(gcbSpacingMark, c):
+		return gcSpacingMark
+	case unicode.Is(gcbPictographic, c):
+		return gcPictographic
+	case unicode.Is(gcbRegional, c):
+		return gcRegional
+	case unicode.Is(gcbPrepend, c):
+		return gcPrepend
+	case unicode.Is(gcbL, c):
+		return gcL
+	case unicode.Is(gcbV, c):
+		return gcV
+	case unicode.Is(gcbT, c):
+		return gcT
+	}
+	return gcOther
+}
+
+// graphemeBreaks(s) returns the indices of the grapheme cluster
+// boundaries of s, including 0 and len(s)
+func graphemeBreaks(s []rune) []int {
+	b := []int{0}
+	if len(s) == 0 {
+		return b
+	}
+	prev := gcbProperty(s[0])
+	pict := prev == gcPictographic // in Pictographic Extend* sequence
+	nri := 0                       // number of preceding regional indicators
+	if prev == gcRegional {
+		nri = 1
+	}
+	for i := 1; i < len(s); i++ {
+		p := gcbProperty(s[i])
+		if !graphemeJoin(prev, p, pict, nri) {
+			b = append(b, i)
+		}
+		if p == gcPictographic {
+			pict = true
+		} else if (p != gcExtend && p != gcZWJ) || prev == gcZWJ {
+			pict = false // not continuing Pictographic Extend* ZWJ
+		}
+		if p == gcRegional {
+			nri++
+		} else {
+			nri = 0
+		}
+		prev = p
+	}
+	return append(b, len(s))
+}
+
+// graphemeJoin(a, b, pict, nri) reports whether there is no grapheme
+// cluster boundary between characters of categories a and b, given
+// whether a ends a Pictographic Extend* (ZWJ) sequence and the number
+// of regional indicators ending at a
+func graphemeJoin(a int, b int, pict bool, nri int) bool {
+	switch {
+	case a == gcCR && b == gcLF: // GB3
+		return true
+	case a == gcCR || a == gcLF || a == gcControl: // GB4
+		return false
+	case b == gcCR || b == gcLF || b == gcControl: // GB5
+		return false
+	case a == gcL && (b == gcL || b == gcV || b == gcLV || b == gcLVT): // GB6
+		return true
+	case (a == gcLV || a == gcV) && (b == gcV || b == gcT): // GB7
+		return true
+	case (a == gcLVT || a == gcT) && b == gcT: // GB8
+		return true
+	case b == gcExtend || b == gcZWJ || b == gcSpacingMark: // GB9, GB9a
+		return true
+	case a == gcPrepend: // GB9b
+		return true
+	case a == gcZWJ && b == gcPictographic && pict: // GB11
+		return true
+	case a == gcRegional && b == gcRegional: // GB12, GB13
+		return nri%2 == 1
+	}
+	return false // GB999
+}
+
+//  -------------------------- display width ---------------------
+
+// A cell is a grapheme cluster and its display width.
+type cell struct {
+	r []rune
+	w int
+}
+
+// cells(s) splits s into grapheme clusters with their display widths
+func cells(s []rune) []cell {
+	b := graphemeBreaks(s)
+	c := make([]cell, len(b)-1)
+	for i := range c {
+		g := s[b[i]:b[i+1]]
+		c[i] = cell{g, clusterWidth(g)}
+	}
+	return c
+}
+
+// clusterWidth(g) returns the display width of grapheme cluster g,
+// which is the width of its first spacing character
+func clusterWidth(g []rune) int {
+	for i, c := range g {
+		switch {
+		case c < 0x20 || (c >= 0x7F && c < 0xA0):
+			return 0 // control character
+		case unicode.Is(zeroWidth, c):
+			continue
+		case unicode.Is(wideChars, c):
+			return 2
+		case unicode.Is(gcbRegional, c) && i+1 < len(g):
+			return 2 // flag
+		case unicode.Is(gcbPictographic, c) &&
+			i+1 < len(g) && g[i+1] == 0xFE0F:
+			return 2 // emoji presentation selector
+		default:
+			return 1
+		}
+	}
+	return 0
+}
+
+// justify(s, w, p, how) pads or truncates s to display width w using
+// padding p, a grapheme cluster at a time, for left ('l'), right ('r'),
+// or center ('c') justification; this extends left(), right(), and
+// center(), which work a character at a time
+func justify(s []rune, w int, p []rune, how byte) []rune {
+	c := cells(s)
+	n := w
+	for _, x := range c {
+		n -= x.w
+	}
+	if n < 0 { // truncate
+		switch how {
+		case 'l':
+			c = fit(c, w, false)
+		case 'r':
+			c = fit(c, w, true)
+		case 'c':
+			d := (-n + 1) / 2 // columns to drop at left
+			for len(c) > 0 && d > 0 {
+				d -= c[0].w
+				c = c[1:]
+			}
+			c = fit(c, w, false)
+		}
+		n = w
+		for _, x := range c {
+			n -= x.w
+		}
+	}
+	var nl, nr int // padding needed at left and right
+	switch how {
+	case 'l':
+		nr = n
+	case 'r':
+		nl = n
+	case 'c':
+		nl = n / 2
+		nr = n - nl
+	}
+	pad := cells(p)
+	r := padding(pad, nl, false)
+	for _, x := range c {
+		r = append(r, x.r...)
+	}
+	return append(r, padding(pad, nr, true)...)
+}
+
+// fit(c, w, fromEnd) returns the longest prefix (or suffix) of c
+// that fits within display width w
+func fit(c []cell, w int, fromEnd bool) []cell {
+	n := 0
+	for i := range c {
+		k := i
+		if fromEnd {
+			k = len(c) - 1 - i
+		}
+		if n+c[k].w > w {
+			if fromEnd {
+				return c[k+1:]
+			}
+			return c[:k]
+		}
+		n += c[k].w
+	}
+	return c
+}
+
+// padding(pad, n, fromEnd) returns n columns of padding, repeating
+// the cells of pad from the start (or aligned to the end);
+// a space is used where a cell of pad does not fit
+func padding(pad []cell, n int, fromEnd bool) []rune {
+	r := make([]cell, 0, n)
+	for i := 0; n > 0; i++ {
+		k := i % len(pad)
+		if fromEnd {
+			k = len(pad) - 1 - k
+		}
+		x := pad[k]
+		if x.w < 1 || x.w > n {
+			x = cell{[]rune{' '}, 1}
+		}
+		r = append(r, x)
+		n -= x.w
+	}
+	s := make([]rune, 0, len(r))
+	for i := range r {
+		if fromEnd {
+			s = append(s, r[len(r)-1-i].r...)
+		} else {
+			s = append(s, r[i].r...)
+		}
+	}
+	return s
+}
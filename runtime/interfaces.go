@@ -114,6 +114,11 @@ type IRank interface {
 	Char(args ...Value) (Value, *Closure) // return type char to Goaldi
 }
 
+// IImage -- a structure whose image can show nested values
+type IImage interface {
+	Image(depth int) string // image, showing components to depth-1
+}
+
 // IVariable -- an assignable trapped variable (simple or subscripted)
 type IVariable interface {
 	Deref() Value           // return dereferenced value
@@ -0,0 +1,134 @@
+#!/usr/bin/env python3
+#
+#  mkucadata.py -- generate ucadata.go, the collation tables for vcollate.go
+#
+#  usage:  python3 mkucadata.py allkeys.txt >ucadata.go
+#
+#  The Default Unicode Collation Element Table (DUCET) is read from
+#  allkeys.txt, such as the copy installed with Perl's Unicode::Collate
+#  in /usr/share/perl/5.36.0/Unicode/Collate.  Its version must match
+#  the Python unicodedata version that mkunidata.py uses for unidata.go,
+#  because collation relies on those tables for normalization.
+#  Nothing is fetched from the network.
+#
+#  Each collation element is packed into 32 bits as
+#  primary<<16 | secondary<<5 | tertiary.
+#  The variable-weighting flag is not kept: Goaldi collation treats
+#  all characters as non-ignorable.
+
+import re
+import sys
+import unicodedata
+
+if len(sys.argv) != 2:
+    sys.exit("usage: python3 mkucadata.py allkeys.txt >ucadata.go")
+ALLKEYS = sys.argv[1]
+ELEMENT = re.compile(r"\[[.*]([0-9A-F]+)\.([0-9A-F]+)\.([0-9A-F]+)\]")
+
+
+def pack(p, s, t):
+    """pack the weights of a collation element"""
+    if p > 0xFFFF or s > 0x1FF or t > 0x1F:
+        sys.exit("weight too large: %X.%X.%X" % (p, s, t))
+    return p << 16 | s << 5 | t
+
+
+def gostring(s):
+    return '"' + "".join("\\u%04X" % c if c <= 0xFFFF
+                         else "\\U%08X" % c for c in s) + '"'
+
+
+def readtable():
+    """read allkeys.txt, returning version, singles, contractions, implicits"""
+    version = None
+    singles = {}
+    contractions = {}
+    implicits = []
+    for line in open(ALLKEYS):
+        line = line.split("#")[0].strip()
+        if not line:
+            continue
+        if line.startswith("@version"):
+            version = line.split()[1]
+            continue
+        if line.startswith("@implicitweights"):
+            r, base = line.split(None, 1)[1].split(";")
+            lo, hi = r.split("..")
+            implicits.append((int(lo, 16), int(hi, 16), int(base, 16)))
+            continue
+        chars, elems = line.split(";")
+        chars = tuple(int(c, 16) for c in chars.split())
+        elems = [pack(int(p, 16), int(s, 16), int(t, 16))
+                 for p, s, t in ELEMENT.findall(elems)]
+        if len(chars) == 1:
+            singles[chars[0]] = elems
+        else:
+            contractions[chars] = elems
+    return version, singles, contractions, implicits
+
+
+def main():
+    version, singles, contractions, implicits = readtable()
+    if version != unicodedata.unidata_version:
+        sys.exit("version mismatch: DUCET %s, Python %s"
+                 % (version, unicodedata.unidata_version))
+    expand = []
+
+    # merge characters with consecutive primaries into runs
+    ranges = []
+    for c in sorted(singles):
+        e = singles[c]
+        if len(e) == 1 and ranges and ranges[-1][3] == 0 \
+                and ranges[-1][1] == c - 1:
+            lo, hi, ce, n = ranges[-1]
+            step = (c - lo) << 16 if ce >> 16 else 0
+            if e[0] == ce + step:
+                ranges[-1][1] = c
+                continue
+        if len(e) == 1:
+            ranges.append([c, c, e[0], 0])
+        else:
+            ranges.append([c, c, len(expand), len(e)])
+            expand.extend(e)
+
+    clist = []
+    for chars in sorted(contractions):
+        e = contractions[chars]
+        clist.append((chars, len(expand), len(e)))
+        expand.extend(e)
+
+    print("//  ucadata.go -- Unicode collation tables for vcollate.go")
+    print("//")
+    print("//  Code generated by mkucadata.py from DUCET %s; DO NOT EDIT."
+          % version)
+    print("\npackage runtime")
+
+    print("\n// ucadataVersion is the DUCET version of these tables")
+    print('const ucadataVersion = "%s"' % version)
+
+    print("\n// ucaRanges gives the collation elements of single characters")
+    print("var ucaRanges = []ucaRange{")
+    for lo, hi, v, n in ranges:
+        print("\t{0x%04X, 0x%04X, 0x%08X, %d}," % (lo, hi, v, n))
+    print("}")
+
+    print("\n// ucaContractions gives the collation elements of sequences")
+    print("var ucaContractions = []ucaContraction{")
+    for chars, i, n in clist:
+        print("\t{%s, %d, %d}," % (gostring(chars), i, n))
+    print("}")
+
+    print("\n// ucaExpansions holds the elements of expansions and contractions")
+    print("var ucaExpansions = []uint32{")
+    for i in range(0, len(expand), 6):
+        print("\t" + " ".join("0x%08X," % e for e in expand[i:i + 6]))
+    print("}")
+
+    print("\n// ucaImplicit gives the blocks that have their own implicit weights")
+    print("var ucaImplicit = []ucaBlock{")
+    for lo, hi, base in sorted(implicits):
+        print("\t{0x%04X, 0x%04X, 0x%04X}," % (lo, hi, base))
+    print("}")
+
+
+main()
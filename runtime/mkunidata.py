@@ -0,0 +1,205 @@
+#!/usr/bin/env python3
+#
+#  mkunidata.py -- generate unidata.go, the Unicode tables for funicode.go
+#
+#  usage:  python3 mkunidata.py unicore-dir >unidata.go
+#
+#  Normalization, case folding, and character widths come from the
+#  Python unicodedata module, whose version sets the Unicode version
+#  of the tables.  This must match the DUCET used by mkucadata.py;
+#  for Unicode 13.0.0, use Python 3.9 or 3.10.
+#
+#  Grapheme cluster break properties come from the Perl "unicore"
+#  directory, such as /usr/share/perl/5.36.0/unicore, which is installed
+#  with Perl and may be of a later Unicode version.  Characters added
+#  after the Python version are given the properties of the unassigned
+#  code points they replaced.  Nothing is fetched from the network.
+
+import sys
+import unicodedata
+
+if len(sys.argv) != 2:
+    sys.exit("usage: python3 mkunidata.py unicore-dir >unidata.go")
+UNICORE = sys.argv[1]
+MAXRUNE = 0x10FFFF
+
+# blocks in which unassigned code points default to East_Asian_Width=W
+WIDEBLOCKS = [(0x3400, 0x4DBF), (0x4E00, 0x9FFF), (0xF900, 0xFAFF),
+              (0x20000, 0x2FFFD), (0x30000, 0x3FFFD)]
+
+
+def vtuple(v):
+    return tuple(int(x) for x in v.split("."))
+
+
+def perltable(name):
+    """read a Perl To/*.pl mapping as a list of (lo, hi, value)"""
+    v = open(UNICORE + "/version").read().strip()
+    if vtuple(v) < vtuple(unicodedata.unidata_version):
+        sys.exit("version mismatch: Perl %s is older than Python %s"
+                 % (v, unicodedata.unidata_version))
+    lines = open(UNICORE + "/To/" + name + ".pl").read()
+    lines = lines[lines.index("<<'END';\n") + 9:lines.index("\nEND\n")]
+    result = []
+    for line in lines.split("\n"):
+        f = line.split("\t")
+        lo = int(f[0], 16)
+        hi = int(f[1], 16) if f[1] else lo
+        result.append((lo, hi, f[2]))
+    return result
+
+
+def ranges(cps):
+    """merge a sorted list of code points into ranges"""
+    result = []
+    for c in cps:
+        if result and result[-1][1] == c - 1:
+            result[-1][1] = c
+        else:
+            result.append([c, c])
+    return result
+
+
+def rangetable(name, comment, rs):
+    """print a Go unicode.RangeTable"""
+    r16 = [r for r in rs if r[1] <= 0xFFFF]
+    r32 = [r for r in rs if r[1] > 0xFFFF]
+    latin = len([r for r in r16 if r[1] <= 0xFF])
+    print("\n// %s" % comment)
+    print("var %s = &unicode.RangeTable{" % name)
+    if r16:
+        print("\tR16: []unicode.Range16{")
+        for lo, hi in r16:
+            print("\t\t{0x%04X, 0x%04X, 1}," % (lo, hi))
+        print("\t},")
+    if r32:
+        print("\tR32: []unicode.Range32{")
+        for lo, hi in r32:
+            print("\t\t{0x%05X, 0x%05X, 1}," % (lo, hi))
+        print("\t},")
+    if latin:
+        print("\tLatinOffset: %d," % latin)
+    print("}")
+
+
+def gostring(s):
+    return '"' + "".join("\\u%04X" % ord(c) if ord(c) <= 0xFFFF
+                         else "\\U%08X" % ord(c) for c in s) + '"'
+
+
+def runemap(name, comment, m):
+    """print a Go map[rune]string"""
+    print("\n// %s" % comment)
+    print("var %s = map[rune]string{" % name)
+    for c in sorted(m):
+        print("\t0x%04X: %s," % (c, gostring(m[c])))
+    print("}")
+
+
+def main():
+    chars = [chr(c) for c in range(MAXRUNE + 1) if not 0xD800 <= c <= 0xDFFF]
+
+    print("//  unidata.go -- Unicode tables for funicode.go")
+    print("//")
+    print("//  Code generated by mkunidata.py from Unicode %s; DO NOT EDIT."
+          % unicodedata.unidata_version)
+    print("\npackage runtime")
+    print('\nimport "unicode"')
+    print("\n// unidataVersion is the Unicode version of these tables")
+    print('const unidataVersion = "%s"' % unicodedata.unidata_version)
+
+    # canonical combining classes
+    print("\n// cccTable gives the nonzero canonical combining classes")
+    print("var cccTable = []cccRange{")
+    run = None
+    for ch in chars + [None]:
+        k = unicodedata.combining(ch) if ch else 0
+        c = ord(ch) if ch else None
+        if run and (k != run[2] or c != run[1] + 1):
+            print("\t{0x%04X, 0x%04X, %d}," % tuple(run))
+            run = None
+        if k:
+            if run:
+                run[1] = c
+            else:
+                run = [c, c, k]
+    print("}")
+
+    # decompositions (one level; applied recursively)
+    canon = {}
+    compat = {}
+    for ch in chars:
+        d = unicodedata.decomposition(ch)
+        if not d:
+            continue
+        f = d.split()
+        if f[0].startswith("<"):
+            compat[ord(ch)] = "".join(chr(int(x, 16)) for x in f[1:])
+        else:
+            canon[ord(ch)] = "".join(chr(int(x, 16)) for x in f)
+    runemap("canonDecomp", "canonDecomp gives canonical decompositions",
+            canon)
+    runemap("compatDecomp", "compatDecomp gives compatibility decompositions",
+            compat)
+
+    # pairs that decompose but are excluded from composition
+    print("\n// compExclusions lists characters with two-character canonical")
+    print("// decompositions that are excluded from composition")
+    print("var compExclusions = []rune{")
+    for c in sorted(canon):
+        d = canon[c]
+        if (len(d) == 2 and unicodedata.combining(chr(c)) == 0
+                and unicodedata.combining(d[0]) == 0
+                and unicodedata.normalize("NFC", chr(c)) != chr(c)):
+            print("\t0x%04X," % c)
+    print("}")
+
+    # full case folding
+    fold = {}
+    for ch in chars:
+        f = ch.casefold()
+        if f != ch:
+            fold[ord(ch)] = f
+    runemap("caseFold", "caseFold gives full Unicode case foldings", fold)
+
+    # grapheme cluster break properties, of this Unicode version:
+    # a character added later was unassigned here, so it was Other,
+    # although reserved pictographic code points are Extended_Pictographic
+    added = set()
+    for lo, hi, v in perltable("Gc"):
+        if v != "Cn":
+            added.update(c for c in range(lo, hi + 1)
+                         if unicodedata.category(chr(c)) == "Cn")
+    gcb = {}
+    for lo, hi, v in perltable("GCB"):
+        if v in ("CR", "LF", "ZWJ", "LV", "LVT"):
+            continue  # handled by code
+        cps = range(lo, hi + 1)
+        if v != "ExtPict_XX":
+            cps = [c for c in cps if c not in added]
+        gcb.setdefault(v, []).extend(cps)
+    for v, name in [("Control", "gcbControl"), ("Extend", "gcbExtend"),
+                    ("Prepend", "gcbPrepend"),
+                    ("SpacingMark", "gcbSpacingMark"),
+                    ("Regional_Indicator", "gcbRegional"),
+                    ("L", "gcbL"), ("V", "gcbV"), ("T", "gcbT"),
+                    ("ExtPict_XX", "gcbPictographic")]:
+        rangetable(name, "%s: Grapheme_Cluster_Break=%s" % (name, v),
+                   ranges(sorted(gcb[v])))
+
+    # display widths, including the default for unassigned ideographs
+    wide = [ord(ch) for ch in chars
+            if unicodedata.east_asian_width(ch) in ("W", "F")
+            and unicodedata.category(ch) != "Cn"
+            or any(lo <= ord(ch) <= hi for lo, hi in WIDEBLOCKS)]
+    rangetable("wideChars", "wideChars: East_Asian_Width=W or F",
+               ranges(wide))
+    zero = [ord(ch) for ch in chars
+            if unicodedata.category(ch) in ("Mn", "Me", "Cf")
+            and ch != "­"]
+    zero.extend(range(0x1160, 0x1200))  # Hangul medial vowels and finals
+    rangetable("zeroWidth", "zeroWidth: nonspacing and format characters",
+               ranges(sorted(set(zero))))
+
+
+main()
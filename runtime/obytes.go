@@ -0,0 +1,84 @@
+//  obytes.go -- bytes operations
+
+package runtime
+
+import (
+	"math/rand"
+	"sync/atomic"
+)
+
+//------------------------------------  Size:  *b
+
+func (v *VBytes) Size() Value {
+	return NewNumber(float64(len(v.b)))
+}
+
+//------------------------------------  Choose:  ?b
+
+func (v *VBytes) Choose(lval Value) Value {
+	n := len(v.b)
+	if n == 0 {
+		return nil // fail
+	}
+	return NewNumber(float64(v.b[rand.Intn(n)]))
+}
+
+//------------------------------------  Dispense:  !b
+
+func (v *VBytes) Dispense(lval Value) (Value, *Closure) {
+	i := -1
+	var c *Closure
+	c = &Closure{func() (Value, *Closure) {
+		i++
+		if i >= len(v.b) {
+			return nil, nil
+		} else {
+			return NewNumber(float64(v.b[i])), c
+		}
+	}}
+	return c.Resume()
+}
+
+//------------------------------------  Concat:  b1 || b2
+
+// VBytes.Concat appends in place if v ends where the claimed part of its
+// array ends and there is room, so that repeated b ||:= x takes linear time.
+// Otherwise the result is a copy with spare capacity for later appends.
+func (v *VBytes) Concat(x Value) Value {
+	w := BytesVal(x)
+	n := len(v.b)
+	m := n + len(w.b)
+	if v.buf != nil && m <= cap(v.b) &&
+		atomic.CompareAndSwapInt64(&v.buf.n, int64(n), int64(m)) {
+		return &VBytes{append(v.b, w.b...), v.buf}
+	}
+	b := make([]byte, 0, 2*m)
+	b = append(append(b, v.b...), w.b...)
+	return &VBytes{b, &byteBuf{int64(m)}}
+}
+
+//------------------------------------  Index:  b[i]
+
+func (v *VBytes) Index(lval Value, x Value) Value {
+	n := len(v.b)
+	i := GoIndex(IntVal(x), n)
+	if i >= n {
+		return nil // fail: subscript out of range
+	}
+	return NewNumber(float64(v.b[i]))
+}
+
+//------------------------------------  Slice:  b[i:j]
+
+func (v *VBytes) Slice(lval Value, x Value, y Value) Value {
+	n := len(v.b)
+	i := GoIndex(IntVal(x), n)
+	j := GoIndex(IntVal(y), n)
+	if i > n || j > n {
+		return nil // fail: subscript out of range
+	}
+	if i > j {
+		i, j = j, i // indexing was backwards
+	}
+	return &VBytes{v.b[i:j:j], nil} // share storage; capacity prevents appends
+}
@@ -143,7 +143,7 @@ var hungChannel = reflect.ValueOf(make(chan interface{}))
 func channelValue(ch Value) reflect.Value {
 	cv := reflect.ValueOf(ch)
 	if cv.Kind() != reflect.Chan {
-		panic(NewExn("Not a channel", ch))
+		panic(NewErr(ErrChannel, ch))
 	}
 	return cv
 }
@@ -26,7 +26,7 @@ func Field(x Value, s string) Value {
 		if mv := UniMethod(x, s); mv != nil {
 			return mv
 		}
-		panic(NewExn("Field not found: "+s, x))
+		panic(NewErrDetail(ErrNoField, s, x))
 	} else {
 		// not a Goaldi type; try reflection
 		return GoField(x, s)
@@ -65,7 +65,7 @@ func GoField(x Value, s string) Value {
 		return mv
 	}
 	// nothing found
-	panic(NewExn("Field not found: "+s, x))
+	panic(NewErrDetail(ErrNoField, s, x))
 }
 
 // Index(lval,x,y) calls x.Index(lval, y) or falls back to reflection.
@@ -78,7 +78,7 @@ func Index(lval Value, x Value, y Value) Value {
 		return TrapMap(x, y)
 	}
 	if xv.Kind() != reflect.Slice && xv.Kind() != reflect.Array {
-		panic(NewExn("Wrong type for indexing", x))
+		panic(NewErr(ErrIndexing, x))
 	}
 	n := xv.Len()
 	i := GoIndex(IntVal(y), n)
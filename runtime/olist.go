@@ -118,6 +118,6 @@ func (v *VList) ListCat(x Value) Value {
 	if w, ok := x.(*VList); ok {
 		return InitList(append(v.Export().([]Value), w.Export().([]Value)...))
 	} else {
-		panic(NewExn("Not a list", x))
+		panic(NewErr(ErrList, x))
 	}
 }
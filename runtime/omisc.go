@@ -53,7 +53,7 @@ func Size(x Value) Value {
 // VNumber.Call -- implement i(e1, e2, e3...)
 func (v *VNumber) Call(env *Env, args []Value, names []string) (Value, *Closure) {
 	if len(names) > 0 {
-		panic(NewExn("Named arguments not allowed", v))
+		panic(NewErr(ErrNamedArgs, v))
 	}
 	i := GoIndex(int(v.Val()), len(args))
 	if i < len(args) {
@@ -69,7 +69,7 @@ func ToBy(e1 Value, e2 Value, e3 Value) (Value, *Closure) {
 	v2 := FloatVal(e2)
 	v3 := FloatVal(e3)
 	if v3 == 0 {
-		panic(NewExn("ToBy: bad increment", e3))
+		panic(NewErr(ErrIncrement, e3))
 	}
 	v1 -= v3
 	var f *Closure
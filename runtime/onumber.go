@@ -1,9 +1,27 @@
 //  onumber.go -- operations on numbers
+//
+//  Numbers are represented as VNumber (float64) values, except for
+//  integers too large to be exact, which are VBigInt values.
+//  Arithmetic on exact integers uses math/big whenever a float64 result
+//  might lose precision, and demotes any small result to a VNumber.
+//  A float beyond the range of exact integers is not exact, even if it
+//  is whole, so arithmetic involving it gives a floating result.
+//
+//  Rationals and decimals are exact.  When mixed with other numbers:
+//  a rational operand gives a rational result, unless the other operand
+//  is a float that is not an integer, which gives an inexact float result;
+//  otherwise, a decimal operand gives a decimal result with the larger
+//  scale of the two operands, treating a float as its shortest decimal form.
+//
+//  Complex numbers combine with any other number to give a complex result.
+//  They can be compared for equality but are otherwise unordered.
 
 package runtime
 
 import (
 	"math"
+	"math/big"
+	"math/cmplx"
 	"math/rand"
 )
 
@@ -11,17 +29,304 @@ import (
 func fval(v Value) float64 {
 	if n, ok := v.(Numerable); ok {
 		return float64(*(n.ToNumber()))
+	} else if _, ok := v.(*VComplex); ok {
+		panic(NewErr(ErrNotReal, v))
 	} else {
-		panic(NewExn("Not a number", v))
+		panic(NewErr(ErrNumber, v))
 	}
 }
 
+// numval(v) returns v converted to a number without loss of precision:
+// either a VNumber or a VBigInt.  It panics if v is not convertible.
+func numval(v Value) Value {
+	switch n := v.(type) {
+	case *VNumber:
+		return n
+	case *VBigInt:
+		return n
+	case *VRational:
+		return n
+	case *VDecimal:
+		return n
+	case *VComplex:
+		return n
+	case *VString:
+		return n.toNumeric()
+	default:
+		if n, ok := v.(Numerable); ok {
+			return n.ToNumber()
+		} else {
+			panic(NewErr(ErrNumber, v))
+		}
+	}
+}
+
+// isExact(n) reports whether n is an exact integer, without allocating
+func isExact(n Value) bool {
+	switch v := n.(type) {
+	case *VBigInt:
+		return true
+	case *VNumber:
+		f := float64(*v)
+		return f == math.Trunc(f) && f <= MAX_EXACT && f >= -MAX_EXACT // not NaN
+	default:
+		return false
+	}
+}
+
+// floatPair(n1, n2) returns the values of n1 and n2 if both are VNumbers
+func floatPair(n1 Value, n2 Value) (float64, float64, bool) {
+	if x, ok := n1.(*VNumber); ok {
+		if y, ok := n2.(*VNumber); ok {
+			return float64(*x), float64(*y), true
+		}
+	}
+	return 0, 0, false
+}
+
+// bigval(n) returns the value of number n as a big.Int,
+// or nil if n is not an exact integer
+func bigval(n Value) *big.Int {
+	switch v := n.(type) {
+	case *VBigInt:
+		return v.Int()
+	case *VNumber:
+		f := float64(*v)
+		if f != math.Trunc(f) || f > MAX_EXACT || f < -MAX_EXACT { // incl NaN
+			return nil
+		}
+		return big.NewInt(int64(f))
+	default:
+		return nil
+	}
+}
+
+// needBig(n1, n2, r) reports whether an operation on numbers n1 and n2
+// with floating result r should be recomputed using big integers:
+// if both operands are exact integers and either is a VBigInt
+// or r is beyond the range of exact integers.
+func needBig(n1 Value, n2 Value, r float64) bool {
+	if !isExact(n1) || !isExact(n2) {
+		return false
+	}
+	_, b1 := n1.(*VBigInt)
+	_, b2 := n2.(*VBigInt)
+	return b1 || b2 || r >= MAX_EXACT || r <= -MAX_EXACT
+}
+
+// bigArith(n1, n2, op) computes op(n1, n2) exactly,
+// returning nil if either operand is not an integer
+func bigArith(n1 Value, n2 Value, op func(z, x, y *big.Int) *big.Int) Value {
+	x := bigval(n1)
+	y := bigval(n2)
+	if x == nil || y == nil {
+		return nil
+	}
+	return NewInteger(op(new(big.Int), x, y))
+}
+
+// bigfloat(n) returns the exact value of number n as a big.Float,
+// or nil if n is NaN
+func bigfloat(n Value) *big.Float {
+	switch v := n.(type) {
+	case *VBigInt:
+		return new(big.Float).SetInt(v.Int())
+	default:
+		f := fval(n)
+		if math.IsNaN(f) {
+			return nil
+		}
+		return big.NewFloat(f)
+	}
+}
+
+// numCompare(n1, n2) compares two numbers exactly, returning -1, 0, or +1,
+// or 2 if they are unordered because one is NaN
+func numCompare(n1 Value, n2 Value) int {
+	a, ok1 := n1.(*VNumber)
+	b, ok2 := n2.(*VNumber)
+	if ok1 && ok2 {
+		switch {
+		case *a < *b:
+			return -1
+		case *a > *b:
+			return +1
+		case *a == *b:
+			return 0
+		default:
+			return 2
+		}
+	}
+	fval(n1) // reject complex operands
+	fval(n2)
+	if class(n1) != cOrdinary || class(n2) != cOrdinary {
+		return ratCompare(n1, n2)
+	}
+	x := bigfloat(n1)
+	y := bigfloat(n2)
+	if x == nil || y == nil {
+		return 2
+	}
+	return x.Cmp(y)
+}
+
+// numEqual(n1, n2) reports whether two numbers are equal,
+// allowing either to be complex
+func numEqual(n1 Value, n2 Value) bool {
+	_, ok1 := n1.(*VComplex)
+	_, ok2 := n2.(*VComplex)
+	if ok1 || ok2 {
+		return cval(n1) == cval(n2)
+	}
+	return numCompare(n1, n2) == 0
+}
+
+// ratCompare(n1, n2) is numCompare for rationals and decimals,
+// treating floats as their shortest decimal forms
+func ratCompare(n1 Value, n2 Value) int {
+	s1, nan1 := infsign(n1)
+	s2, nan2 := infsign(n2)
+	switch {
+	case nan1 || nan2:
+		return 2
+	case s1 < s2:
+		return -1
+	case s1 > s2:
+		return +1
+	case s1 != 0:
+		return 0
+	default:
+		return ratval(n1).Cmp(ratval(n2))
+	}
+}
+
+// infsign(n) returns the sign of n if it is infinite, or else 0,
+// and also reports whether n is NaN
+func infsign(n Value) (int, bool) {
+	if v, ok := n.(*VNumber); ok {
+		f := float64(*v)
+		switch {
+		case math.IsNaN(f):
+			return 0, true
+		case math.IsInf(f, +1):
+			return +1, false
+		case math.IsInf(f, -1):
+			return -1, false
+		}
+	}
+	return 0, false
+}
+
+// classes of mixed arithmetic, in increasing order of precedence
+const (
+	cOrdinary = iota // VNumber and VBigInt
+	cDecimal         // VDecimal
+	cRational        // VRational
+)
+
+// class(n) returns the arithmetic class of number n
+func class(n Value) int {
+	switch n.(type) {
+	case *VRational:
+		return cRational
+	case *VDecimal:
+		return cDecimal
+	default:
+		return cOrdinary
+	}
+}
+
+// numClass(n1, n2) returns the class of arithmetic on n1 and n2
+// according to the rules given at the top of this file
+func numClass(n1 Value, n2 Value) int {
+	c := class(n1)
+	if c2 := class(n2); c2 > c {
+		c = c2
+	}
+	if c == cRational && (isFraction(n1) || isFraction(n2)) {
+		return cOrdinary
+	}
+	return c
+}
+
+// isFraction(n) reports whether n is a float that is not an integer
+func isFraction(n Value) bool {
+	v, ok := n.(*VNumber)
+	if !ok {
+		return false
+	}
+	f := float64(*v)
+	return f != math.Trunc(f) || math.IsInf(f, 0) // incl NaN
+}
+
+// exactArith(n1, n2, op) computes op(n1, n2) as a rational or decimal
+// if either operand is rational or decimal, and otherwise returns nil
+func exactArith(n1 Value, n2 Value, op func(z, x, y *big.Rat) *big.Rat) Value {
+	switch numClass(n1, n2) {
+	case cRational:
+		return NewRational(op(new(big.Rat), ratval(n1), ratval(n2)))
+	case cDecimal:
+		return NewDecimal(op(new(big.Rat), ratval(n1), ratval(n2)),
+			maxScale(n1, n2))
+	default:
+		return nil
+	}
+}
+
+// complexArith(n1, n2, op) computes op(n1, n2) as a complex number
+// if either operand is complex, and otherwise returns nil
+func complexArith(n1 Value, n2 Value, op func(x, y complex128) complex128) Value {
+	_, ok1 := n1.(*VComplex)
+	_, ok2 := n2.(*VComplex)
+	if ok1 || ok2 {
+		return NewComplex(op(cval(n1), cval(n2)))
+	}
+	return nil
+}
+
+// maxScale(n1, n2) returns the larger decimal scale of n1 and n2
+func maxScale(n1 Value, n2 Value) int {
+	s := decscale(n1)
+	if s2 := decscale(n2); s2 > s {
+		return s2
+	}
+	return s
+}
+
+// ratQuo(z, x, y) sets z to x / y, throwing an exception if y is zero
+func ratQuo(z, x, y *big.Rat) *big.Rat {
+	if y.Sign() == 0 {
+		panic(NewErr(ErrDivide))
+	}
+	return z.Quo(x, y)
+}
+
+// ratMod(z, x, y) sets z to the remainder of x / y truncated to integer
+func ratMod(z, x, y *big.Rat) *big.Rat {
+	q := new(big.Rat).SetInt(ratTrunc(ratQuo(new(big.Rat), x, y)))
+	return z.Sub(x, q.Mul(q, y))
+}
+
+// ratTrunc(r) returns r truncated to an integer
+func ratTrunc(r *big.Rat) *big.Int {
+	return new(big.Int).Quo(r.Num(), r.Denom())
+}
+
+// globalSource is a rand.Source drawing from the global random sequence,
+// so that big random values honor seed()
+type globalSource struct{}
+
+func (globalSource) Int63() int64 { return rand.Int63() }
+func (globalSource) Seed(int64)   {}
+
+var globalRand = rand.New(globalSource{})
+
 //------------------------------------  Choose:  ?e
 
 func (v1 *VNumber) Choose(unused Value) Value {
 	n := v1.Val()
 	if n < 0 {
-		panic(NewExn("?n < 0", v1))
+		panic(NewErr(ErrNegRandom, v1))
 	} else if n == 0 {
 		return NewNumber(rand.Float64())
 	} else /* n > 0 */ {
@@ -29,12 +334,23 @@ func (v1 *VNumber) Choose(unused Value) Value {
 	}
 }
 
+func (v1 *VBigInt) Choose(unused Value) Value {
+	if v1.Int().Sign() < 0 {
+		panic(NewErr(ErrNegRandom, v1))
+	}
+	return NewInteger(new(big.Int).Rand(globalRand, v1.Int()))
+}
+
 //------------------------------------  Dispense:  !e
 
 func (v1 *VNumber) Dispense(unused Value) (Value, *Closure) {
 	return ToBy(ONE, v1, ONE)
 }
 
+func (v1 *VBigInt) Dispense(unused Value) (Value, *Closure) {
+	return ToBy(ONE, v1, ONE)
+}
+
 //------------------------------------  Numerate:  +e
 
 type INumerate interface {
@@ -42,13 +358,29 @@ type INumerate interface {
 }
 
 func (v1 *VString) Numerate() Value {
-	return v1.ToNumber()
+	return v1.toNumeric()
 }
 
 func (v1 *VNumber) Numerate() Value {
 	return v1
 }
 
+func (v1 *VBigInt) Numerate() Value {
+	return v1
+}
+
+func (v1 *VRational) Numerate() Value {
+	return v1
+}
+
+func (v1 *VDecimal) Numerate() Value {
+	return v1
+}
+
+func (v1 *VComplex) Numerate() Value {
+	return v1
+}
+
 //------------------------------------  Negate:  -e
 
 type INegate interface {
@@ -56,13 +388,29 @@ type INegate interface {
 }
 
 func (v1 *VString) Negate() Value {
-	return v1.ToNumber().Negate()
+	return numval(v1).(INegate).Negate()
 }
 
 func (v1 *VNumber) Negate() Value {
 	return NewNumber(-float64(*v1))
 }
 
+func (v1 *VBigInt) Negate() Value {
+	return NewInteger(new(big.Int).Neg(v1.Int()))
+}
+
+func (v1 *VRational) Negate() Value {
+	return NewRational(new(big.Rat).Neg(v1.Rat()))
+}
+
+func (v1 *VDecimal) Negate() Value {
+	return &VDecimal{new(big.Int).Neg(v1.unscaled), v1.scale}
+}
+
+func (v1 *VComplex) Negate() Value {
+	return NewComplex(-v1.Val())
+}
+
 //------------------------------------  Add:  e1 + e2
 
 type IAdd interface {
@@ -70,11 +418,48 @@ type IAdd interface {
 }
 
 func (v1 *VString) Add(v2 Value) Value {
-	return v1.ToNumber().Add(v2)
+	return numval(v1).(IAdd).Add(v2)
 }
 
 func (v1 *VNumber) Add(v2 Value) Value {
-	return NewNumber(float64(*v1) + fval(v2))
+	return add(v1, numval(v2))
+}
+
+func (v1 *VBigInt) Add(v2 Value) Value {
+	return add(v1, numval(v2))
+}
+
+func (v1 *VRational) Add(v2 Value) Value {
+	return add(v1, numval(v2))
+}
+
+func (v1 *VDecimal) Add(v2 Value) Value {
+	return add(v1, numval(v2))
+}
+
+func (v1 *VComplex) Add(v2 Value) Value {
+	return add(v1, numval(v2))
+}
+
+func add(n1 Value, n2 Value) Value {
+	if x, y, ok := floatPair(n1, n2); ok { // fast path for small results
+		if r := x + y; r < MAX_EXACT && r > -MAX_EXACT {
+			return NewNumber(r)
+		}
+	}
+	if z := complexArith(n1, n2, func(x, y complex128) complex128 { return x + y }); z != nil {
+		return z
+	}
+	if z := exactArith(n1, n2, (*big.Rat).Add); z != nil {
+		return z
+	}
+	r := fval(n1) + fval(n2)
+	if needBig(n1, n2, r) {
+		if z := bigArith(n1, n2, (*big.Int).Add); z != nil {
+			return z
+		}
+	}
+	return NewNumber(r)
 }
 
 //------------------------------------  Sub:  e1 - e2
@@ -84,11 +469,48 @@ type ISub interface {
 }
 
 func (v1 *VString) Sub(v2 Value) Value {
-	return v1.ToNumber().Sub(v2)
+	return numval(v1).(ISub).Sub(v2)
 }
 
 func (v1 *VNumber) Sub(v2 Value) Value {
-	return NewNumber(float64(*v1) - fval(v2))
+	return sub(v1, numval(v2))
+}
+
+func (v1 *VBigInt) Sub(v2 Value) Value {
+	return sub(v1, numval(v2))
+}
+
+func (v1 *VRational) Sub(v2 Value) Value {
+	return sub(v1, numval(v2))
+}
+
+func (v1 *VDecimal) Sub(v2 Value) Value {
+	return sub(v1, numval(v2))
+}
+
+func (v1 *VComplex) Sub(v2 Value) Value {
+	return sub(v1, numval(v2))
+}
+
+func sub(n1 Value, n2 Value) Value {
+	if x, y, ok := floatPair(n1, n2); ok { // fast path for small results
+		if r := x - y; r < MAX_EXACT && r > -MAX_EXACT {
+			return NewNumber(r)
+		}
+	}
+	if z := complexArith(n1, n2, func(x, y complex128) complex128 { return x - y }); z != nil {
+		return z
+	}
+	if z := exactArith(n1, n2, (*big.Rat).Sub); z != nil {
+		return z
+	}
+	r := fval(n1) - fval(n2)
+	if needBig(n1, n2, r) {
+		if z := bigArith(n1, n2, (*big.Int).Sub); z != nil {
+			return z
+		}
+	}
+	return NewNumber(r)
 }
 
 //------------------------------------  Mul:  e1 * e2
@@ -98,11 +520,48 @@ type IMul interface {
 }
 
 func (v1 *VString) Mul(v2 Value) Value {
-	return v1.ToNumber().Mul(v2)
+	return numval(v1).(IMul).Mul(v2)
 }
 
 func (v1 *VNumber) Mul(v2 Value) Value {
-	return NewNumber(float64(*v1) * fval(v2))
+	return mul(v1, numval(v2))
+}
+
+func (v1 *VBigInt) Mul(v2 Value) Value {
+	return mul(v1, numval(v2))
+}
+
+func (v1 *VRational) Mul(v2 Value) Value {
+	return mul(v1, numval(v2))
+}
+
+func (v1 *VDecimal) Mul(v2 Value) Value {
+	return mul(v1, numval(v2))
+}
+
+func (v1 *VComplex) Mul(v2 Value) Value {
+	return mul(v1, numval(v2))
+}
+
+func mul(n1 Value, n2 Value) Value {
+	if x, y, ok := floatPair(n1, n2); ok { // fast path for small results
+		if r := x * y; r < MAX_EXACT && r > -MAX_EXACT {
+			return NewNumber(r)
+		}
+	}
+	if z := complexArith(n1, n2, func(x, y complex128) complex128 { return x * y }); z != nil {
+		return z
+	}
+	if z := exactArith(n1, n2, (*big.Rat).Mul); z != nil {
+		return z
+	}
+	r := fval(n1) * fval(n2)
+	if needBig(n1, n2, r) {
+		if z := bigArith(n1, n2, (*big.Int).Mul); z != nil {
+			return z
+		}
+	}
+	return NewNumber(r)
 }
 
 //------------------------------------  Div:  e1 / e2
@@ -112,11 +571,49 @@ type IDiv interface {
 }
 
 func (v1 *VString) Div(v2 Value) Value {
-	return v1.ToNumber().Div(v2)
+	return numval(v1).(IDiv).Div(v2)
 }
 
 func (v1 *VNumber) Div(v2 Value) Value {
-	return NewNumber(float64(*v1) / fval(v2))
+	return div(v1, numval(v2))
+}
+
+func (v1 *VBigInt) Div(v2 Value) Value {
+	return div(v1, numval(v2))
+}
+
+func (v1 *VRational) Div(v2 Value) Value {
+	return div(v1, numval(v2))
+}
+
+func (v1 *VDecimal) Div(v2 Value) Value {
+	return div(v1, numval(v2))
+}
+
+func (v1 *VComplex) Div(v2 Value) Value {
+	return div(v1, numval(v2))
+}
+
+// div(n1, n2) gives an exact integer quotient for an evenly divisible
+// VBigInt, and a floating result otherwise
+func div(n1 Value, n2 Value) Value {
+	if z := complexArith(n1, n2, func(x, y complex128) complex128 { return x / y }); z != nil {
+		return z
+	}
+	if z := exactArith(n1, n2, ratQuo); z != nil {
+		return z
+	}
+	if needBig(n1, n2, 0) {
+		x := bigval(n1)
+		y := bigval(n2)
+		if x != nil && y != nil && y.Sign() != 0 {
+			q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+			if r.Sign() == 0 {
+				return NewInteger(q)
+			}
+		}
+	}
+	return NewNumber(fval(n1) / fval(n2))
 }
 
 //------------------------------------  Divt:  e1 // e2  (divide and truncate)
@@ -126,11 +623,58 @@ type IDivt interface {
 }
 
 func (v1 *VString) Divt(v2 Value) Value {
-	return v1.ToNumber().Divt(v2)
+	return numval(v1).(IDivt).Divt(v2)
 }
 
 func (v1 *VNumber) Divt(v2 Value) Value {
-	return NewNumber(float64(int64(float64(*v1) / fval(v2))))
+	return divt(v1, numval(v2))
+}
+
+func (v1 *VBigInt) Divt(v2 Value) Value {
+	return divt(v1, numval(v2))
+}
+
+func (v1 *VRational) Divt(v2 Value) Value {
+	return divt(v1, numval(v2))
+}
+
+func (v1 *VDecimal) Divt(v2 Value) Value {
+	return divt(v1, numval(v2))
+}
+
+func (v1 *VComplex) Divt(v2 Value) Value {
+	return divt(v1, numval(v2))
+}
+
+func divt(n1 Value, n2 Value) Value {
+	if numClass(n1, n2) != cOrdinary {
+		return NewInteger(ratTrunc(ratQuo(new(big.Rat), ratval(n1), ratval(n2))))
+	}
+	a, ok1 := n1.(*VNumber)
+	b, ok2 := n2.(*VNumber)
+	if ok1 && ok2 && *b != 0 {
+		i := int64(*a)
+		j := int64(*b)
+		if a.IsExactInt(i) && b.IsExactInt(j) {
+			return NewNumber(float64(i / j)) // exact integer division
+		}
+	}
+	r := fval(n1) / fval(n2)
+	if needBig(n1, n2, r) {
+		if z := bigDivide(n1, n2, (*big.Int).Quo); z != nil {
+			return z
+		}
+	}
+	return NewNumber(math.Trunc(r))
+}
+
+// bigDivide(n1, n2, op) is bigArith for division operations,
+// returning nil also for a zero divisor
+func bigDivide(n1 Value, n2 Value, op func(z, x, y *big.Int) *big.Int) Value {
+	if y := bigval(n2); y == nil || y.Sign() == 0 {
+		return nil
+	}
+	return bigArith(n1, n2, op)
 }
 
 //------------------------------------  Mod:  e1 % e2  (remainder)
@@ -140,11 +684,39 @@ type IMod interface {
 }
 
 func (v1 *VString) Mod(v2 Value) Value {
-	return v1.ToNumber().Mod(v2)
+	return numval(v1).(IMod).Mod(v2)
 }
 
 func (v1 *VNumber) Mod(v2 Value) Value {
-	return NewNumber(math.Mod(float64(*v1), fval(v2)))
+	return mod(v1, numval(v2))
+}
+
+func (v1 *VBigInt) Mod(v2 Value) Value {
+	return mod(v1, numval(v2))
+}
+
+func (v1 *VRational) Mod(v2 Value) Value {
+	return mod(v1, numval(v2))
+}
+
+func (v1 *VDecimal) Mod(v2 Value) Value {
+	return mod(v1, numval(v2))
+}
+
+func (v1 *VComplex) Mod(v2 Value) Value {
+	return mod(v1, numval(v2))
+}
+
+func mod(n1 Value, n2 Value) Value {
+	if z := exactArith(n1, n2, ratMod); z != nil {
+		return z
+	}
+	if needBig(n1, n2, 0) {
+		if z := bigDivide(n1, n2, (*big.Int).Rem); z != nil {
+			return z
+		}
+	}
+	return NewNumber(math.Mod(fval(n1), fval(n2)))
 }
 
 //------------------------------------  Power:  e1 ^ e2
@@ -154,11 +726,99 @@ type IPower interface {
 }
 
 func (v1 *VString) Power(v2 Value) Value {
-	return v1.ToNumber().Power(v2)
+	return numval(v1).(IPower).Power(v2)
 }
 
 func (v1 *VNumber) Power(v2 Value) Value {
-	return NewNumber(math.Pow(float64(*v1), fval(v2)))
+	return power(v1, numval(v2))
+}
+
+func (v1 *VBigInt) Power(v2 Value) Value {
+	return power(v1, numval(v2))
+}
+
+func (v1 *VRational) Power(v2 Value) Value {
+	return power(v1, numval(v2))
+}
+
+func (v1 *VDecimal) Power(v2 Value) Value {
+	return power(v1, numval(v2))
+}
+
+func (v1 *VComplex) Power(v2 Value) Value {
+	return power(v1, numval(v2))
+}
+
+// power(n1, n2) computes an exact result for an integer raised to
+// a nonnegative integer power, if the result is not too large
+func power(n1 Value, n2 Value) Value {
+	if z := complexArith(n1, n2, cpow); z != nil {
+		return z
+	}
+	if c := numClass(n1, n2); c != cOrdinary {
+		if z := ratPow(ratval(n1), ratval(n2)); z == nil {
+			// not an exact case; fall through to float
+		} else if c == cRational {
+			return NewRational(z)
+		} else {
+			return NewDecimal(z, maxScale(n1, n2))
+		}
+	}
+	r := math.Pow(fval(n1), fval(n2))
+	if needBig(n1, n2, r) {
+		x := bigval(n1)
+		y := bigval(n2)
+		if x != nil && y != nil && y.Sign() >= 0 &&
+			y.BitLen() <= 32 && x.BitLen()*int(y.Int64()) <= maxPowerBits {
+			return NewInteger(new(big.Int).Exp(x, y, nil))
+		}
+	}
+	return NewNumber(r)
+}
+
+const maxPowerBits = 1 << 24 // limit on size of exact result of power()
+
+// cpow(x, y) computes x ^ y for complex numbers, using repeated
+// multiplication for a small integer y to avoid rounding errors
+func cpow(x complex128, y complex128) complex128 {
+	k := real(y)
+	if imag(y) != 0 || k != math.Trunc(k) || math.Abs(k) > 1024 {
+		return cmplx.Pow(x, y)
+	}
+	z := complex(1, 0)
+	for i := int(math.Abs(k)); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			z *= x
+		}
+		x *= x
+	}
+	if k < 0 {
+		z = 1 / z
+	}
+	return z
+}
+
+// ratPow(x, y) computes x ^ y exactly for an integer y,
+// returning nil if y is not an integer or the result would be too large
+func ratPow(x *big.Rat, y *big.Rat) *big.Rat {
+	if !y.IsInt() || y.Num().BitLen() > 32 {
+		return nil
+	}
+	k := y.Num().Int64()
+	e := big.NewInt(k)
+	if k < 0 {
+		if x.Sign() == 0 {
+			panic(NewErr(ErrDivide))
+		}
+		e.Neg(e)
+		x = new(big.Rat).Inv(x)
+	}
+	if (x.Num().BitLen()+x.Denom().BitLen())*int(e.Int64()) > maxPowerBits {
+		return nil
+	}
+	n := new(big.Int).Exp(x.Num(), e, nil)
+	d := new(big.Int).Exp(x.Denom(), e, nil)
+	return new(big.Rat).SetFrac(n, d)
 }
 
 //------------------------------------  NumLT:  e1 < e2
@@ -168,11 +828,43 @@ type INumLT interface {
 }
 
 func (v1 *VString) NumLT(v2 Value) (Value, *Closure) {
-	return v1.ToNumber().NumLT(v2)
+	return numval(v1).(INumLT).NumLT(v2)
 }
 
 func (v1 *VNumber) NumLT(v2 Value) (Value, *Closure) {
-	if float64(*v1) < fval(v2) {
+	if numCompare(v1, numval(v2)) == -1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VBigInt) NumLT(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) == -1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VRational) NumLT(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) == -1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VDecimal) NumLT(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) == -1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VComplex) NumLT(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) == -1 {
 		return Return(v2)
 	} else {
 		return Fail()
@@ -186,11 +878,43 @@ type INumLE interface {
 }
 
 func (v1 *VString) NumLE(v2 Value) (Value, *Closure) {
-	return v1.ToNumber().NumLE(v2)
+	return numval(v1).(INumLE).NumLE(v2)
 }
 
 func (v1 *VNumber) NumLE(v2 Value) (Value, *Closure) {
-	if float64(*v1) <= fval(v2) {
+	if numCompare(v1, numval(v2)) <= 0 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VBigInt) NumLE(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) <= 0 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VRational) NumLE(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) <= 0 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VDecimal) NumLE(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) <= 0 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VComplex) NumLE(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) <= 0 {
 		return Return(v2)
 	} else {
 		return Fail()
@@ -204,11 +928,43 @@ type INumEQ interface {
 }
 
 func (v1 *VString) NumEQ(v2 Value) (Value, *Closure) {
-	return v1.ToNumber().NumEQ(v2)
+	return numval(v1).(INumEQ).NumEQ(v2)
 }
 
 func (v1 *VNumber) NumEQ(v2 Value) (Value, *Closure) {
-	if float64(*v1) == fval(v2) {
+	if numEqual(v1, numval(v2)) {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VBigInt) NumEQ(v2 Value) (Value, *Closure) {
+	if numEqual(v1, numval(v2)) {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VRational) NumEQ(v2 Value) (Value, *Closure) {
+	if numEqual(v1, numval(v2)) {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VDecimal) NumEQ(v2 Value) (Value, *Closure) {
+	if numEqual(v1, numval(v2)) {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VComplex) NumEQ(v2 Value) (Value, *Closure) {
+	if numEqual(v1, numval(v2)) {
 		return Return(v2)
 	} else {
 		return Fail()
@@ -222,11 +978,43 @@ type INumNE interface {
 }
 
 func (v1 *VString) NumNE(v2 Value) (Value, *Closure) {
-	return v1.ToNumber().NumNE(v2)
+	return numval(v1).(INumNE).NumNE(v2)
 }
 
 func (v1 *VNumber) NumNE(v2 Value) (Value, *Closure) {
-	if float64(*v1) != fval(v2) {
+	if !numEqual(v1, numval(v2)) {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VBigInt) NumNE(v2 Value) (Value, *Closure) {
+	if !numEqual(v1, numval(v2)) {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VRational) NumNE(v2 Value) (Value, *Closure) {
+	if !numEqual(v1, numval(v2)) {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VDecimal) NumNE(v2 Value) (Value, *Closure) {
+	if !numEqual(v1, numval(v2)) {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VComplex) NumNE(v2 Value) (Value, *Closure) {
+	if !numEqual(v1, numval(v2)) {
 		return Return(v2)
 	} else {
 		return Fail()
@@ -240,11 +1028,43 @@ type INumGE interface {
 }
 
 func (v1 *VString) NumGE(v2 Value) (Value, *Closure) {
-	return v1.ToNumber().NumGE(v2)
+	return numval(v1).(INumGE).NumGE(v2)
 }
 
 func (v1 *VNumber) NumGE(v2 Value) (Value, *Closure) {
-	if float64(*v1) >= fval(v2) {
+	if c := numCompare(v1, numval(v2)); c == 0 || c == 1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VBigInt) NumGE(v2 Value) (Value, *Closure) {
+	if c := numCompare(v1, numval(v2)); c == 0 || c == 1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VRational) NumGE(v2 Value) (Value, *Closure) {
+	if c := numCompare(v1, numval(v2)); c == 0 || c == 1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VDecimal) NumGE(v2 Value) (Value, *Closure) {
+	if c := numCompare(v1, numval(v2)); c == 0 || c == 1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VComplex) NumGE(v2 Value) (Value, *Closure) {
+	if c := numCompare(v1, numval(v2)); c == 0 || c == 1 {
 		return Return(v2)
 	} else {
 		return Fail()
@@ -258,11 +1078,43 @@ type INumGT interface {
 }
 
 func (v1 *VString) NumGT(v2 Value) (Value, *Closure) {
-	return v1.ToNumber().NumGT(v2)
+	return numval(v1).(INumGT).NumGT(v2)
 }
 
 func (v1 *VNumber) NumGT(v2 Value) (Value, *Closure) {
-	if float64(*v1) > fval(v2) {
+	if numCompare(v1, numval(v2)) == 1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VBigInt) NumGT(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) == 1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VRational) NumGT(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) == 1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VDecimal) NumGT(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) == 1 {
+		return Return(v2)
+	} else {
+		return Fail()
+	}
+}
+
+func (v1 *VComplex) NumGT(v2 Value) (Value, *Closure) {
+	if numCompare(v1, numval(v2)) == 1 {
 		return Return(v2)
 	} else {
 		return Fail()
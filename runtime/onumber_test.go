@@ -4,6 +4,7 @@ package runtime
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 )
 
@@ -67,3 +68,50 @@ func ck4n(t *testing.T, label string, n0 float64, v1, v2, v3, v4 Value) {
 		t.Errorf("Expected %g, got %g %g %g %g", n0, n1, n2, n3, n4)
 	}
 }
+
+func TestBigInt(t *testing.T) {
+	m := NewNumber(MAX_EXACT)
+	b := m.Add(ONE)
+	if _, ok := b.(*VBigInt); !ok || b.(*VBigInt).String() != "9007199254740993" {
+		t.Errorf("MAX_EXACT+1: expected VBigInt, got %#v", b)
+	}
+	s := NewString("9007199254740993")
+	if n := s.Sub(m); n.(*VNumber).Val() != 1 {
+		t.Errorf("string - MAX_EXACT: expected 1, got %#v", n)
+	}
+	d := b.(*VBigInt).Sub(b)
+	if _, ok := d.(*VNumber); !ok {
+		t.Errorf("b - b: expected VNumber, got %#v", d)
+	}
+	if !m.Before(b, 0) || b.(*VBigInt).Before(m, 0) {
+		t.Errorf("MAX_EXACT and MAX_EXACT+1 sort incorrectly")
+	}
+}
+
+func TestRoundHalfEven(t *testing.T) {
+	for _, c := range []struct {
+		n, d, want int64
+	}{
+		{5, 2, 2}, {7, 2, 4}, {-5, 2, -2}, {-7, 2, -4},
+		{10, 3, 3}, {-11, 3, -4}, {11, -3, -4}, {6, 3, 2},
+	} {
+		r := roundHalfEven(big.NewInt(c.n), big.NewInt(c.d))
+		if r.Int64() != c.want {
+			t.Errorf("roundHalfEven(%d, %d): expected %d, got %v",
+				c.n, c.d, c.want, r)
+		}
+	}
+	d := NewDecimal(big.NewRat(1999, 100), 2).Mul(NewNumber(1.08))
+	if s := d.(*VDecimal).String(); s != "21.59" {
+		t.Errorf("19.99 * 1.08: expected 21.59, got %s", s)
+	}
+}
+
+func TestArithAllocs(t *testing.T) {
+	x, y := NewNumber(12345), NewNumber(678.5)
+	for _, op := range []func(Value, Value) Value{add, sub, mul} {
+		if n := testing.AllocsPerRun(100, func() { op(x, y) }); n > 1 {
+			t.Errorf("float arithmetic made %v allocations, expected 1", n)
+		}
+	}
+}
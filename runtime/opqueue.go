@@ -0,0 +1,36 @@
+//  opqueue.go -- priority queue operations
+
+package runtime
+
+// VPQueue.Size -- return the number of values in the queue
+func (Q *VPQueue) Size() Value {
+	Q.Lock()
+	defer Q.Unlock()
+	return NewNumber(float64(len(Q.heap)))
+}
+
+// VPQueue.Take -- remove and return the first value
+func (Q *VPQueue) Take(lval Value) Value {
+	return Q.first(true)
+}
+
+// VPQueue.Send -- add a value to the queue
+func (Q *VPQueue) Send(lval Value, x Value) Value {
+	Q.add(x)
+	return x
+}
+
+// VPQueue.Dispense -- generate the values in order, without removing them
+func (Q *VPQueue) Dispense(lval Value) (Value, *Closure) {
+	l := Q.sorted()
+	i := -1
+	var c *Closure
+	c = &Closure{func() (Value, *Closure) {
+		i++
+		if i < len(l) {
+			return l[i], c
+		}
+		return Fail()
+	}}
+	return c.Resume()
+}
@@ -28,7 +28,7 @@ func (v *VRecord) Field(f string) Value {
 		return mv
 	}
 	//  nothing found
-	panic(NewExn("Field not found: "+f, v))
+	panic(NewErrDetail(ErrNoField, f, v))
 }
 
 // VRecord.Index(lval, x) implements an indexed reference R[x]
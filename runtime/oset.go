@@ -10,26 +10,46 @@ import (
 //------------------------------------  Size:  *S
 
 func (S *VSet) Size() Value {
-	return NewNumber(float64(len(*S)))
+	return NewNumber(float64(len(S.members)))
+}
+
+func (C *VCset) Size() Value {
+	return NewNumber(float64(C.size()))
 }
 
 //------------------------------------  Choose:  ?S
 
 func (S *VSet) Choose(lval Value) Value {
-	n := len(*S)
+	n := len(S.members)
 	if n == 0 {
 		return nil // fail
 	}
-	vlist := reflect.ValueOf(*S).MapKeys()
+	vlist := reflect.ValueOf(S.members).MapKeys()
 	x := vlist[rand.Intn(n)].Interface()
 	return Import(x) // convert back from GoKey
 }
 
+func (C *VCset) Choose(lval Value) Value {
+	n := C.size()
+	if n == 0 {
+		return nil // fail
+	}
+	i := rune(rand.Intn(n))
+	for _, x := range C.r {
+		if i <= x.hi-x.lo {
+			return RuneString([]rune{x.lo + i})
+		}
+		i -= x.hi - x.lo + 1
+	}
+	return nil // not reached
+}
+
 //------------------------------------  Take:  @S
 
 func (S *VSet) Take(lval Value) Value {
-	for v := range *S { // for just one
-		delete(*S, v)
+	for v := range S.members { // for just one
+		delete(S.members, v)
+		S.index.remove(v)
 		return Import(v) // convert back from GoKey
 	}
 	return nil // must have been empty: fail
@@ -38,7 +58,7 @@ func (S *VSet) Take(lval Value) Value {
 //------------------------------------  Dispense:  !S
 
 func (S *VSet) Dispense(lval Value) (Value, *Closure) {
-	vlist := reflect.ValueOf(*S).MapKeys()
+	vlist := reflect.ValueOf(S.members).MapKeys()
 	i := -1
 	var c *Closure
 	c = &Closure{func() (Value, *Closure) {
@@ -52,23 +72,53 @@ func (S *VSet) Dispense(lval Value) (Value, *Closure) {
 	return c.Resume()
 }
 
+func (C *VCset) Dispense(lval Value) (Value, *Closure) {
+	k := 0
+	c := rune(-1)
+	var f *Closure
+	f = &Closure{func() (Value, *Closure) {
+		if c < 0 || c >= C.r[k].hi {
+			if c >= 0 {
+				k++
+			}
+			if k >= len(C.r) {
+				return nil, nil
+			}
+			c = C.r[k].lo
+		} else {
+			c++
+		}
+		return RuneString([]rune{c}), f
+	}}
+	return f.Resume()
+}
+
 //------------------------------------  Send:  S @: x
 
 func (S *VSet) Send(lval Value, x Value) Value {
-	(*S)[GoKey(x)] = true
+	S.add(x)
 	return x
 }
 
 //------------------------------------  Index:  S[x]
 
 func (S *VSet) Index(lval Value, x Value) Value {
-	if (*S)[GoKey(x)] {
+	if S.has(x) {
 		return x // found x in set
 	} else {
 		return nil // fail
 	}
 }
 
+func (C *VCset) Index(lval Value, x Value) Value {
+	s := ToString(x).ToRunes()
+	if len(s) == 1 && C.Has(s[0]) {
+		return x // found x in cset
+	} else {
+		return nil // fail
+	}
+}
+
 //------------------------------------  Union: S1 ++ S2
 
 type IUnion interface {
@@ -77,16 +127,21 @@ type IUnion interface {
 
 func (S1 *VSet) Union(x Value) Value {
 	S2 := SetVal(x)
-	S3 := NewSet(EMPTYLIST)
-	for k := range *S1 {
-		(*S3)[k] = true
-	}
-	for k := range *S2 {
-		(*S3)[k] = true
+	S3 := S1.Copy().(*VSet)
+	for k := range S2.members {
+		S3.add(Import(k))
 	}
 	return S3
 }
 
+func (C1 *VCset) Union(x Value) Value {
+	return C1.union(CsetVal(x))
+}
+
+func (s *VString) Union(x Value) Value {
+	return CsetVal(s).Union(x)
+}
+
 //------------------------------------  SetDiff: S1 -- S2
 
 type ISetDiff interface {
@@ -94,16 +149,24 @@ type ISetDiff interface {
 }
 
 func (S1 *VSet) SetDiff(x Value) Value {
-	S2 := SetVal(x)
-	S3 := NewSet(EMPTYLIST)
-	for k := range *S1 {
-		if !(*S2)[k] {
-			(*S3)[k] = true
+	S2 := S1.like(SetVal(x))
+	S3 := S1.empty()
+	for k := range S1.members {
+		if !S2.has(Import(k)) {
+			S3.add(Import(k))
 		}
 	}
 	return S3
 }
 
+func (C1 *VCset) SetDiff(x Value) Value {
+	return C1.intersect(CsetVal(x).complement())
+}
+
+func (s *VString) SetDiff(x Value) Value {
+	return CsetVal(s).SetDiff(x)
+}
+
 //------------------------------------  Intersect: S1 ** S2
 
 type IIntersect interface {
@@ -111,12 +174,20 @@ type IIntersect interface {
 }
 
 func (S1 *VSet) Intersect(x Value) Value {
-	S2 := SetVal(x)
-	S3 := NewSet(EMPTYLIST)
-	for k := range *S1 {
-		if (*S2)[k] {
-			(*S3)[k] = true
+	S2 := S1.like(SetVal(x))
+	S3 := S1.empty()
+	for k := range S1.members {
+		if S2.has(Import(k)) {
+			S3.add(Import(k))
 		}
 	}
 	return S3
 }
+
+func (C1 *VCset) Intersect(x Value) Value {
+	return C1.intersect(CsetVal(x))
+}
+
+func (s *VString) Intersect(x Value) Value {
+	return CsetVal(s).Intersect(x)
+}
@@ -0,0 +1,98 @@
+//  osortmap.go -- sorted map operations
+
+package runtime
+
+import (
+	"math/rand"
+)
+
+// VSortMap.Size -- return the number of entries
+func (M *VSortMap) Size() Value {
+	return NewNumber(float64(M.root.count()))
+}
+
+// VSortMap.Choose -- return random entry as (key,value) pair
+func (M *VSortMap) Choose(lval Value) Value {
+	if M.root == nil {
+		return nil
+	}
+	return M.root.nth(rand.Intn(M.root.count())).elem()
+}
+
+// VSortMap.Take -- remove and return the first entry as (key,value) pair
+func (M *VSortMap) Take(lval Value) Value {
+	n := M.end(false)
+	if n == nil {
+		return nil
+	}
+	M.remove(n.key)
+	return n.elem()
+}
+
+// VSortMap.Dispense -- generate entries as (key,value) pairs in key order
+func (M *VSortMap) Dispense(lval Value) (Value, *Closure) {
+	return M.traverse(NilValue, NilValue, true)
+}
+
+// M.Index(lval, x) implements the [] operator.
+func (M *VSortMap) Index(lval Value, x Value) Value {
+	return &vSortMapTrap{M, x}
+}
+
+// smNode.elem() returns the entry of n as a (key,value) pair
+func (n *smNode) elem() Value {
+	return ElemType.New([]Value{n.key, n.val})
+}
+
+// VSortMap.traverse(from, to, up) generates (key,value) pairs from key
+// from through key to, in ascending order if up and descending if not.
+// A nil bound is unlimited.  The generator tolerates changes to M
+// between resumptions by finding each entry afresh from the last key.
+func (M *VSortMap) traverse(from Value, to Value, up bool) (Value, *Closure) {
+	var last *smNode
+	var c *Closure
+	c = &Closure{func() (Value, *Closure) {
+		var n *smNode
+		switch {
+		case last != nil:
+			n = M.seek(last.key, last.serial, up, true)
+		case from != NilValue:
+			n = M.seek(from, M.arrival(from), up, false)
+		default:
+			n = M.end(!up)
+		}
+		if n == nil {
+			return Fail()
+		}
+		if to != NilValue {
+			if c := smCompare(n.key, n.serial, to, M.arrival(to)); up && c > 0 || !up && c < 0 {
+				return Fail()
+			}
+		}
+		last = n
+		return n.elem(), c
+	}}
+	return c.Resume()
+}
+
+//  -------------------------- trapped references ---------------------
+
+// vSortMapTrap is a trapped reference M[k] into a sorted map
+type vSortMapTrap struct {
+	m   *VSortMap // sorted map
+	key Value     // key
+}
+
+// vSortMapTrap.Deref() returns the indexed value, or the default if not found
+func (t *vSortMapTrap) Deref() Value {
+	if n := t.m.find(t.key); n != nil {
+		return n.val
+	}
+	return t.m.dfval
+}
+
+// vSortMapTrap.Assign(x) stores x as a map entry using the trapped key
+func (t *vSortMapTrap) Assign(x Value) IVariable {
+	t.m.put(t.key, x)
+	return t
+}
@@ -14,7 +14,7 @@ func sval(v Value) *VString {
 	if n, ok := v.(Stringable); ok {
 		return n.ToString()
 	} else {
-		panic(NewExn("Not a string", v))
+		panic(NewErr(ErrString, v))
 	}
 }
 
@@ -24,6 +24,22 @@ func (s *VNumber) Size() Value {
 	return s.ToString().Size()
 }
 
+func (s *VBigInt) Size() Value {
+	return s.ToString().Size()
+}
+
+func (s *VRational) Size() Value {
+	return s.ToString().Size()
+}
+
+func (s *VDecimal) Size() Value {
+	return s.ToString().Size()
+}
+
+func (s *VComplex) Size() Value {
+	return s.ToString().Size()
+}
+
 func (s *VString) Size() Value {
 	return NewNumber(float64(s.length()))
 }
@@ -67,7 +83,7 @@ func (s *VString) Take(lval Value) Value {
 			return nil // fail
 		}
 	} else {
-		panic(NewExn("Not a variable", s))
+		panic(NewErr(ErrNotVar, s))
 	}
 }
 
@@ -79,7 +95,7 @@ func (s *VString) Send(lval Value, x Value) Value {
 		v.Assign(scat(s, 0, s.length(), t, 0, t.length(), EMPTY, 0, 0))
 		return t
 	} else {
-		panic(NewExn("Not a variable", s))
+		panic(NewErr(ErrNotVar, s))
 	}
 }
 
@@ -93,6 +109,26 @@ func (s *VNumber) Concat(t Value) Value {
 	return s.ToString().Concat(t)
 }
 
+func (s *VBigInt) Concat(t Value) Value {
+	return s.ToString().Concat(t)
+}
+
+func (s *VRational) Concat(t Value) Value {
+	return s.ToString().Concat(t)
+}
+
+func (s *VDecimal) Concat(t Value) Value {
+	return s.ToString().Concat(t)
+}
+
+func (s *VComplex) Concat(t Value) Value {
+	return s.ToString().Concat(t)
+}
+
+func (s *VCset) Concat(t Value) Value {
+	return s.ToString().Concat(t)
+}
+
 func (s *VString) Concat(x Value) Value {
 	t := sval(x)
 	return scat(s, 0, s.length(), t, 0, t.length(), EMPTY, 0, 0)
@@ -104,6 +140,22 @@ func (s *VNumber) Index(lval Value, x Value) Value {
 	return s.ToString().Index(lval, x)
 }
 
+func (s *VBigInt) Index(lval Value, x Value) Value {
+	return s.ToString().Index(lval, x)
+}
+
+func (s *VRational) Index(lval Value, x Value) Value {
+	return s.ToString().Index(lval, x)
+}
+
+func (s *VDecimal) Index(lval Value, x Value) Value {
+	return s.ToString().Index(lval, x)
+}
+
+func (s *VComplex) Index(lval Value, x Value) Value {
+	return s.ToString().Index(lval, x)
+}
+
 func (s *VString) Index(lval Value, x Value) Value {
 	i := IntVal(x)
 	n := s.length()
@@ -121,6 +173,26 @@ func (s *VNumber) Slice(lval Value, x Value, y Value) Value {
 	return s.ToString().Slice(lval, x, y)
 }
 
+func (s *VBigInt) Slice(lval Value, x Value, y Value) Value {
+	return s.ToString().Slice(lval, x, y)
+}
+
+func (s *VRational) Slice(lval Value, x Value, y Value) Value {
+	return s.ToString().Slice(lval, x, y)
+}
+
+func (s *VDecimal) Slice(lval Value, x Value, y Value) Value {
+	return s.ToString().Slice(lval, x, y)
+}
+
+func (s *VComplex) Slice(lval Value, x Value, y Value) Value {
+	return s.ToString().Slice(lval, x, y)
+}
+
+func (s *VCset) Slice(lval Value, x Value, y Value) Value {
+	return s.ToString().Slice(lval, x, y)
+}
+
 func (s *VString) Slice(lval Value, x Value, y Value) Value {
 	i := IntVal(x)
 	j := IntVal(y)
@@ -146,6 +218,26 @@ func (s *VNumber) StrLT(x Value) Value {
 	return s.ToString().StrLT(x)
 }
 
+func (s *VBigInt) StrLT(x Value) Value {
+	return s.ToString().StrLT(x)
+}
+
+func (s *VRational) StrLT(x Value) Value {
+	return s.ToString().StrLT(x)
+}
+
+func (s *VDecimal) StrLT(x Value) Value {
+	return s.ToString().StrLT(x)
+}
+
+func (s *VComplex) StrLT(x Value) Value {
+	return s.ToString().StrLT(x)
+}
+
+func (s *VCset) StrLT(x Value) Value {
+	return s.ToString().StrLT(x)
+}
+
 func (s *VString) StrLT(x Value) Value {
 	if s.compare(sval(x)) < 0 {
 		return x
@@ -164,6 +256,26 @@ func (s *VNumber) StrLE(x Value) Value {
 	return s.ToString().StrLE(x)
 }
 
+func (s *VBigInt) StrLE(x Value) Value {
+	return s.ToString().StrLE(x)
+}
+
+func (s *VRational) StrLE(x Value) Value {
+	return s.ToString().StrLE(x)
+}
+
+func (s *VDecimal) StrLE(x Value) Value {
+	return s.ToString().StrLE(x)
+}
+
+func (s *VComplex) StrLE(x Value) Value {
+	return s.ToString().StrLE(x)
+}
+
+func (s *VCset) StrLE(x Value) Value {
+	return s.ToString().StrLE(x)
+}
+
 func (s *VString) StrLE(x Value) Value {
 	if s.compare(sval(x)) <= 0 {
 		return x
@@ -182,6 +294,26 @@ func (s *VNumber) StrEQ(x Value) Value {
 	return s.ToString().StrEQ(x)
 }
 
+func (s *VBigInt) StrEQ(x Value) Value {
+	return s.ToString().StrEQ(x)
+}
+
+func (s *VRational) StrEQ(x Value) Value {
+	return s.ToString().StrEQ(x)
+}
+
+func (s *VDecimal) StrEQ(x Value) Value {
+	return s.ToString().StrEQ(x)
+}
+
+func (s *VComplex) StrEQ(x Value) Value {
+	return s.ToString().StrEQ(x)
+}
+
+func (s *VCset) StrEQ(x Value) Value {
+	return s.ToString().StrEQ(x)
+}
+
 func (s *VString) StrEQ(x Value) Value {
 	t := sval(x)
 	if s.length() != t.length() {
@@ -204,6 +336,26 @@ func (s *VNumber) StrNE(x Value) Value {
 	return s.ToString().StrNE(x)
 }
 
+func (s *VBigInt) StrNE(x Value) Value {
+	return s.ToString().StrNE(x)
+}
+
+func (s *VRational) StrNE(x Value) Value {
+	return s.ToString().StrNE(x)
+}
+
+func (s *VDecimal) StrNE(x Value) Value {
+	return s.ToString().StrNE(x)
+}
+
+func (s *VComplex) StrNE(x Value) Value {
+	return s.ToString().StrNE(x)
+}
+
+func (s *VCset) StrNE(x Value) Value {
+	return s.ToString().StrNE(x)
+}
+
 func (s *VString) StrNE(x Value) Value {
 	t := sval(x)
 	if s.length() != t.length() {
@@ -226,6 +378,26 @@ func (s *VNumber) StrGE(x Value) Value {
 	return s.ToString().StrGE(x)
 }
 
+func (s *VBigInt) StrGE(x Value) Value {
+	return s.ToString().StrGE(x)
+}
+
+func (s *VRational) StrGE(x Value) Value {
+	return s.ToString().StrGE(x)
+}
+
+func (s *VDecimal) StrGE(x Value) Value {
+	return s.ToString().StrGE(x)
+}
+
+func (s *VComplex) StrGE(x Value) Value {
+	return s.ToString().StrGE(x)
+}
+
+func (s *VCset) StrGE(x Value) Value {
+	return s.ToString().StrGE(x)
+}
+
 func (s *VString) StrGE(x Value) Value {
 	if s.compare(sval(x)) >= 0 {
 		return x
@@ -244,6 +416,26 @@ func (s *VNumber) StrGT(x Value) Value {
 	return s.ToString().StrGT(x)
 }
 
+func (s *VBigInt) StrGT(x Value) Value {
+	return s.ToString().StrGT(x)
+}
+
+func (s *VRational) StrGT(x Value) Value {
+	return s.ToString().StrGT(x)
+}
+
+func (s *VDecimal) StrGT(x Value) Value {
+	return s.ToString().StrGT(x)
+}
+
+func (s *VComplex) StrGT(x Value) Value {
+	return s.ToString().StrGT(x)
+}
+
+func (s *VCset) StrGT(x Value) Value {
+	return s.ToString().StrGT(x)
+}
+
 func (s *VString) StrGT(x Value) Value {
 	if s.compare(sval(x)) > 0 {
 		return x
@@ -39,3 +39,19 @@ func ck4s(t *testing.T, label string, s0 string, v1, v2, v3, v4 Value) {
 }
 
 //  for nspair() see onumber_test.go
+
+func TestSubstrAllocs(t *testing.T) {
+	for _, s := range []*VString{NewString("abcdefghij"),
+		NewString("äöüéèàçñøå"), NewString("a♡b♢c♣d♠")} {
+		s.flatten()
+		if n := testing.AllocsPerRun(100, func() { s.substr(4, 5) }); n != 0 {
+			t.Errorf("one-character slice of %s made %v allocations", s, n)
+		}
+		if n := testing.AllocsPerRun(100, func() { s.substr(2, 5) }); n > 1 {
+			t.Errorf("slice of %s made %v allocations, expected 1", s, n)
+		}
+		if c := s.substr(5, 6); c.ToUTF8() != string(s.ToRunes()[5]) {
+			t.Errorf("slice of %s gave %s", s, c)
+		}
+	}
+}
@@ -19,12 +19,33 @@ func (T *VTable) Choose(lval Value) Value {
 
 // VTable.Take -- return random element as (key,value) pair
 func (T *VTable) Take(lval Value) Value {
-	return TakeMap(T.data)
+	kv := ChooseMap(T.data)
+	if kv != nil {
+		TrapMap(T, kv.(*VRecord).Data[0]).Delete()
+	}
+	return kv
 }
 
 // VTable.Dispense -- generate table contents as (key,value) pairs
 func (T *VTable) Dispense(lval Value) (Value, *Closure) {
-	return DispenseMap(T.data)
+	if T.order == nil {
+		return DispenseMap(T.data)
+	}
+	klist := T.order.keys()
+	i := -1
+	var c *Closure
+	c = &Closure{func() (Value, *Closure) {
+		for {
+			i++
+			if i >= len(klist) {
+				return Fail()
+			}
+			if v, ok := T.data[klist[i]]; ok { // if didn't disappear
+				return ElemType.New([]Value{Import(klist[i]), v}), c
+			}
+		}
+	}}
+	return c.Resume()
 }
 
 // T.Index(lval, x) implements the [] operator.
@@ -87,6 +108,8 @@ type vMapTrap struct {
 	dfval Value         // default value if a Goaldi map
 	mapv  reflect.Value // underlying Go map
 	keyv  reflect.Value // key converted to appropriate Go type
+	index keyIndex      // index of structured keys, if keyed by value
+	order *keyOrder     // order of keys, if an ordered table
 }
 
 // TrapMap(T,k) creates a trapped variable for T[k]
@@ -94,11 +117,13 @@ func TrapMap(x Value, key Value) *vMapTrap {
 	if T, ok := x.(*VTable); ok {
 		// this is a Goaldi table; must convert string or number key
 		tv := reflect.ValueOf(T.data)
-		return &vMapTrap{true, T.dfval, tv, reflect.ValueOf(GoKey(key))}
+		kv := reflect.ValueOf(T.index.lookup(key))
+		return &vMapTrap{true, T.dfval, tv, kv, T.index, T.order}
 	} else {
 		tv := reflect.ValueOf(x)
 		// otherwise, key will be converted by passfunc
-		return &vMapTrap{false, nil, tv, passfunc(tv.Type().Key())(key)}
+		return &vMapTrap{false, nil, tv, passfunc(tv.Type().Key())(key),
+			nil, nil}
 	}
 }
 
@@ -122,6 +147,11 @@ func (t *vMapTrap) Deref() Value {
 // vMapTrap.Assign(x) stores x as a map entry using the trapped key
 func (t *vMapTrap) Assign(x Value) IVariable {
 	if t.gmap { // if Goaldi table
+		if !t.Exists() {
+			// a new structured key is replaced by the copy that is indexed
+			t.keyv = reflect.ValueOf(t.index.insert(t.keyv.Interface()))
+			t.order.add(t.keyv.Interface())
+		}
 		t.mapv.SetMapIndex(t.keyv, reflect.ValueOf(x))
 	} else {
 		t.mapv.SetMapIndex(t.keyv, passfunc(t.mapv.Type().Elem())(x))
@@ -131,5 +161,9 @@ func (t *vMapTrap) Assign(x Value) IVariable {
 
 // vMapTrap.Delete() removes the entry, if any, associated with the trapped key
 func (t *vMapTrap) Delete() {
+	if t.Exists() {
+		t.index.remove(t.keyv.Interface())
+		t.order.remove(t.keyv.Interface())
+	}
 	t.mapv.SetMapIndex(t.keyv, reflect.Value{})
 }
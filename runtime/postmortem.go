@@ -0,0 +1,98 @@
+//  postmortem.go -- post-mortem dump of an uncaught exception
+//
+//  If the dynamic constant %postmortem names a file when an exception
+//  goes uncaught, the interpreter records the variables of each Goaldi
+//  procedure frame as the exception propagates, and the catcher then
+//  writes them to that file.  Structures are shown as images to the
+//  depth given by %pmdepth.
+
+package runtime
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"time"
+)
+
+// FrameVar records one variable of a procedure frame for a post-mortem dump
+type FrameVar struct {
+	Kind  string // "param", "local", "static", or "dynamic"
+	Name  string // variable name
+	Value Value  // value at time of exception
+}
+
+// SourceName is the name of the source file containing main(), if known;
+// it identifies the program in a post-mortem dump
+var SourceName string
+
+func init() {
+	EnvInit("postmortem", NilValue)  // if non-nil, post-mortem file name
+	EnvInit("pmdepth", NewNumber(2)) // image depth for post-mortem dump
+}
+
+// WantPostMortem(env) reports whether a post-mortem dump is requested
+// in environment env
+func WantPostMortem(env *Env) bool {
+	return env.Lookup("postmortem", true) != NilValue
+}
+
+// CallFrame.Keep(env, vars) saves the environment and variables of
+// a procedure frame for a post-mortem dump
+func (x *CallFrame) Keep(env *Env, vars []FrameVar) {
+	x.env = env
+	x.vars = vars
+}
+
+// PostMortem(x) writes a post-mortem dump of panic value x
+// if any frame of the traceback saved its variables.
+func PostMortem(x interface{}) {
+	// list the frames, innermost first
+	var frames []*CallFrame
+	for f, ok := x.(*CallFrame); ok; f, ok = f.cause.(*CallFrame) {
+		frames = append([]*CallFrame{f}, frames...)
+	}
+	// find the innermost frame that saved its environment
+	var env *Env
+	for _, f := range frames {
+		if f.env != nil {
+			env = f.env
+			break
+		}
+	}
+	if env == nil {
+		return // no dump requested
+	}
+	fname := ToString(env.Lookup("postmortem", true)).ToUTF8()
+	depth := IntVal(env.Lookup("pmdepth", true))
+	file, err := os.Create(fname)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot write post-mortem dump: %v\n", err)
+		return
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "Goaldi post-mortem dump, %s\n",
+		time.Now().Format("2006-01-02 15:04:05"))
+	program := SourceName
+	if program == "" {
+		program = os.Args[0]
+	}
+	fmt.Fprintf(w, "Program: %s\n\n", program)
+	Diagnose(w, x)
+	for i, f := range frames {
+		fmt.Fprintf(w, "\nFrame %d: %s\n", i+1, f)
+		for _, v := range f.offv {
+			if v != nil {
+				fmt.Fprintf(w, "   offending value: %s\n", ImageDepth(v, depth))
+			}
+		}
+		for _, v := range f.vars {
+			fmt.Fprintf(w, "   %-7s %s = %s\n",
+				v.Kind, v.Name, ImageDepth(v.Value, depth))
+		}
+	}
+	fmt.Fprintf(w, "\nEnd of dump\n")
+}
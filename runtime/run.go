@@ -5,6 +5,7 @@ package runtime
 import (
 	"os"
 	"runtime/pprof"
+	"sync"
 )
 
 // Run wraps a Goaldi procedure in an environment and an exception catcher,
@@ -16,10 +17,62 @@ func Run(p Value, arglist []Value) {
 	p.(ICall).Call(env, arglist, []string{})
 }
 
+// termination procedures registered by atexit(), in order of registration
+var exitProcs []Value
+var exitLock sync.Mutex
+
 // Shutdown terminates execution with the given exit code.
+// It first calls any termination procedures registered by atexit()
+// and then flushes and closes all open files.
 func Shutdown(e int) {
-	STDOUT.(*VFile).Flush()
-	STDERR.(*VFile).Flush()
+	RunAtExit()
+	CloseAll()
 	pprof.StopCPUProfile()
 	os.Exit(e)
 }
+
+// RunAtExit calls the registered termination procedures, most recent first.
+// Each is removed from the list before it is called, so that a procedure
+// that calls exit() does not cause itself to be called again.
+// An exception thrown by one procedure is reported on %stderr,
+// after which the remaining procedures are called.
+func RunAtExit() {
+	for {
+		exitLock.Lock()
+		n := len(exitProcs)
+		if n == 0 {
+			exitLock.Unlock()
+			return
+		}
+		p := exitProcs[n-1]
+		exitProcs = exitProcs[:n-1]
+		exitLock.Unlock()
+		callAtExit(p)
+	}
+}
+
+// callAtExit calls one termination procedure, reporting any exception
+func callAtExit(p Value) {
+	defer func() {
+		if x := recover(); x != nil {
+			STDOUT.(*VFile).Flush()
+			Diagnose(os.Stderr, x)
+		}
+	}()
+	p.(ICall).Call(NewEnv(nil), []Value{}, []string{})
+}
+
+// atexit(p) registers procedure p to be called with no arguments
+// when the program terminates, whether by returning from main(),
+// calling exit() or stop(), or because of an uncaught exception.
+// Procedures are called in the reverse order of their registration,
+// and then all open files are flushed and closed.
+// atexit(p) returns p.
+func AtExit(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("atexit", args)
+	p := ProcArg(args, 0, NilValue).(ICall)
+	exitLock.Lock()
+	exitProcs = append(exitProcs, p)
+	exitLock.Unlock()
+	return Return(p)
+}
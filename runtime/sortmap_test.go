@@ -0,0 +1,88 @@
+//  sortmap_test.go -- test balancing of sorted maps
+
+package runtime
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// smCheck verifies the order, heights, and sizes of a subtree
+// and returns its height
+func smCheck(t *testing.T, n *smNode, lo, hi *smNode) int {
+	if n == nil {
+		return 0
+	}
+	if lo != nil && smCompare(lo.key, lo.serial, n.key, n.serial) >= 0 ||
+		hi != nil && smCompare(n.key, n.serial, hi.key, hi.serial) >= 0 {
+		t.Fatalf("key %v out of order", n.key)
+	}
+	l := smCheck(t, n.left, lo, n)
+	r := smCheck(t, n.right, n, hi)
+	if l-r > 1 || r-l > 1 {
+		t.Fatalf("unbalanced at key %v: %d vs %d", n.key, l, r)
+	}
+	if n.size != n.left.count()+n.right.count()+1 {
+		t.Fatalf("bad size at key %v", n.key)
+	}
+	h := l + 1
+	if r >= l {
+		h = r + 1
+	}
+	if n.height != h {
+		t.Fatalf("bad height at key %v", n.key)
+	}
+	return h
+}
+
+func TestSortMap(t *testing.T) {
+	M := NewSortMap(NilValue)
+	present := make(map[int]bool)
+	for i := 0; i < 5000; i++ {
+		k := rand.Intn(500)
+		if rand.Intn(3) == 0 {
+			M.remove(NewNumber(float64(k)))
+			delete(present, k)
+		} else {
+			M.put(NewNumber(float64(k)), NewNumber(float64(i)))
+			present[k] = true
+		}
+		if i%100 == 0 {
+			smCheck(t, M.root, nil, nil)
+		}
+	}
+	smCheck(t, M.root, nil, nil)
+	if M.root.count() != len(present) {
+		t.Fatalf("size %d, expected %d", M.root.count(), len(present))
+	}
+	for k := 0; k < 500; k++ {
+		if (M.find(NewNumber(float64(k))) != nil) != present[k] {
+			t.Fatalf("membership of %d wrong", k)
+		}
+	}
+}
+
+func TestSortMapStructKeys(t *testing.T) {
+	M := NewSortMap(NilValue)
+	keys := make([]Value, 200)
+	for i := range keys {
+		keys[i] = NewList(0, NilValue) // all sort equally
+		M.put(keys[i], NewNumber(float64(i)))
+	}
+	for i := 0; i < len(keys); i += 3 {
+		M.remove(keys[i])
+	}
+	smCheck(t, M.root, nil, nil)
+	for i, k := range keys {
+		n := M.find(k)
+		if (n != nil) != (i%3 != 0) {
+			t.Fatalf("membership of key %d wrong", i)
+		}
+		if n != nil && n.val.(*VNumber).Val() != float64(i) {
+			t.Fatalf("key %d has value %v", i, n.val)
+		}
+	}
+	if M.find(NewList(0, NilValue)) != nil {
+		t.Fatalf("found a key never added")
+	}
+}
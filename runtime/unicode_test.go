@@ -4,7 +4,10 @@ package runtime
 
 import (
 	"math/rand"
+	"strconv"
+	"strings"
 	"testing"
+	"unicode"
 )
 
 const maxUniLen = 7
@@ -104,3 +107,62 @@ func randomUniString(len int, low rune, high rune) string {
 	}
 	return s
 }
+
+func TestRope(t *testing.T) {
+	// build long strings from pieces of differing representations
+	for _, high := range []rune{'~', 'ÿ', '힏', '\U0010FFFF'} {
+		u := ""
+		s := EMPTY
+		for i := 0; i < 100; i++ {
+			p := randomUniString(rand.Intn(5), ' '+1, high-1)
+			u += p
+			switch i % 3 {
+			case 0:
+				s = concat(s, NewString(p))
+			case 1:
+				s = concat(s, RuneString([]rune(p)))
+			case 2:
+				q := NewString("<" + p + ">")
+				s = concat(s, q.substr(1, q.length()-1))
+			}
+		}
+		expect(t, "rope utf8", "x"+u, concat(NewString("x"), s).ToUTF8())
+		r := []rune(u)
+		expect(t, "rope length", len(r), s.length())
+		f := RuneString(r)
+		if s.compare(f) != 0 || f.compare(s) != 0 {
+			t.Error("rope comparison", u)
+		}
+		for i := 0; i < 20; i++ {
+			j := rand.Intn(len(r) + 1)
+			k := j + rand.Intn(len(r)-j+1)
+			expect(t, "rope slice", string(r[j:k]), s.substr(j, k).ToUTF8())
+		}
+		expect(t, "rope runes", u, string(s.ToRunes()))
+		expect(t, "rope utf8", u, s.ToUTF8())
+	}
+}
+
+// TestUnidataVersion checks that the generated tables of unidata.go
+// are no newer than the unicode package that supplies other properties
+func TestUnidataVersion(t *testing.T) {
+	t.Logf("unidata.go: Unicode %s; unicode package: Unicode %s",
+		unidataVersion, unicode.Version)
+	if versionBefore(unicode.Version, unidataVersion) {
+		t.Errorf("unidata.go Unicode %s is newer than Go's %s",
+			unidataVersion, unicode.Version)
+	}
+}
+
+// versionBefore(a, b) reports whether version a precedes version b
+func versionBefore(a, b string) bool {
+	va, vb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(va) && i < len(vb); i++ {
+		x, _ := strconv.Atoi(va[i])
+		y, _ := strconv.Atoi(vb[i])
+		if x != y {
+			return x < y
+		}
+	}
+	return len(va) < len(vb)
+}
@@ -0,0 +1,106 @@
+//  vbigint.go -- VBigInt, integers too large for a VNumber
+//
+//  A VBigInt is a Goaldi number holding an integer whose magnitude
+//  exceeds MAX_EXACT, beyond which a VNumber cannot represent every
+//  integer exactly.  Integer arithmetic promotes its result to a VBigInt
+//  when necessary, and any result small enough for a VNumber is demoted.
+//  Both are of type "number" and are interchangeable in Goaldi code.
+
+package runtime
+
+import (
+	"math/big"
+)
+
+type VBigInt big.Int
+
+// NewInteger(z) returns integer z as a Goaldi number:
+// a VNumber if it can be represented exactly, otherwise a VBigInt.
+// The VBigInt shares its value with z, which must not be changed later.
+func NewInteger(z *big.Int) Value {
+	if z.IsInt64() {
+		i := z.Int64()
+		if i <= MAX_EXACT && i >= -MAX_EXACT {
+			return NewNumber(float64(i))
+		}
+	}
+	return (*VBigInt)(z)
+}
+
+var _ ICore = (*VBigInt)(big.NewInt(1))      // validate implementation
+var _ Numerable = (*VBigInt)(big.NewInt(1))  // validate implementation
+var _ Stringable = (*VBigInt)(big.NewInt(1)) // validate implementation
+
+// VBigInt.Int -- return underlying big.Int, which must not be changed
+func (v *VBigInt) Int() *big.Int {
+	return (*big.Int)(v)
+}
+
+// VBigInt.Val -- return nearest float64 value
+func (v *VBigInt) Val() float64 {
+	f, _ := new(big.Float).SetInt(v.Int()).Float64()
+	return f
+}
+
+// VBigInt.String -- default conversion to Go string returns all digits
+func (v *VBigInt) String() string {
+	return v.Int().String()
+}
+
+// VBigInt.GoString -- convert to Go string for image() and printf("%#v")
+func (v *VBigInt) GoString() string {
+	return v.Int().String()
+}
+
+// VBigInt.ToString -- convert to Goaldi string
+func (v *VBigInt) ToString() *VString {
+	return NewString(v.String())
+}
+
+// VBigInt.ToNumber -- convert to nearest VNumber, losing precision
+func (v *VBigInt) ToNumber() *VNumber {
+	return NewNumber(v.Val())
+}
+
+// VBigInt.Type -- return the number type
+func (v *VBigInt) Type() IRank {
+	return NumberType
+}
+
+// VBigInt.Copy returns itself
+func (v *VBigInt) Copy() Value {
+	return v
+}
+
+// VBigInt.Before compares two numbers for sorting
+func (a *VBigInt) Before(b Value, i int) bool {
+	return numCompare(a, b) < 0
+}
+
+// VBigInt.Identical -- check equality for === operator
+func (a *VBigInt) Identical(x Value) Value {
+	if _, ok := x.(*VBigInt); ok && numCompare(a, x) == 0 {
+		return x
+	} else {
+		return nil
+	}
+}
+
+// VBigInt.Import returns itself
+func (v *VBigInt) Import() Value {
+	return v
+}
+
+// VBigInt.Export returns a copy of the value as a *big.Int
+func (v *VBigInt) Export() interface{} {
+	return new(big.Int).Set(v.Int())
+}
+
+// bigKey is the Go map key for a VBigInt (see GoKey)
+type bigKey string
+
+// bigKey.Import converts a map key back into a VBigInt
+func (k bigKey) Import() Value {
+	z, _ := new(big.Int).SetString(string(k), 10)
+	return NewInteger(z)
+}
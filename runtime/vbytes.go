@@ -0,0 +1,235 @@
+//  vbytes.go -- VBytes, the Goaldi type "bytes"
+//
+//  A bytes value is an immutable sequence of 8-bit bytes, for binary data.
+//  Unlike a string, it is indexed by byte, and its elements are integers.
+//  Conversion between bytes and strings requires an explicit encoding.
+
+package runtime
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// A bytes value wraps a Go byte slice that is never modified.
+// A slice made by concatenation may have spare capacity for extending it
+// in place; its shared growth record tracks how much of the capacity
+// has been claimed by values sharing the same array.
+type VBytes struct {
+	b   []byte
+	buf *byteBuf // growth record, or nil if b has no spare capacity
+}
+
+// byteBuf records the number of bytes in use in a shared array
+type byteBuf struct {
+	n int64 // bytes claimed, updated atomically
+}
+
+// NewBytes -- construct a Goaldi bytes value from a copy of a Go []byte
+func NewBytes(b []byte) *VBytes {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return &VBytes{c, nil}
+}
+
+const rBytes = 22            // declare sort ranking
+var _ ICore = &VBytes{}      // validate implementation
+var _ IIdentical = &VBytes{} // validate implementation
+
+// BytesType is the bytes instance of type type.
+var BytesType = NewType("bytes", "b", rBytes, Bytes, BytesMethods,
+	"bytes", "x,enc", "create byte string")
+
+// Declare methods
+var BytesMethods = MethodTable([]*VProcedure{
+	DefMeth((*VBytes).Decode, "decode", "enc", "convert to string"),
+})
+
+// bytes(x, enc) returns x as a bytes value.
+// If x is a list, its elements must be integers from 0 to 255.
+// Otherwise x is converted to a string and encoded using enc,
+// which is one of "utf-8" (the default), "latin-1", "ascii",
+// "utf-16be", or "utf-16le".
+func Bytes(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("bytes", args)
+	x := ProcArg(args, 0, EMPTY)
+	switch v := x.(type) {
+	case *VBytes:
+		return Return(v)
+	case *VList:
+		b := make([]byte, len(v.data))
+		for i, e := range v.data {
+			b[i] = byteVal(e)
+		}
+		return Return(&VBytes{b, nil})
+	default:
+		enc := encoding(ProcArg(args, 1, NilValue))
+		return Return(&VBytes{encode(ToString(x), enc), nil})
+	}
+}
+
+// b.decode(enc) converts the bytes of b into a string using encoding enc,
+// which is one of "utf-8" (the default), "latin-1", "ascii",
+// "utf-16be", or "utf-16le".
+// Invalid or unencodable bytes become the replacement character U+FFFD.
+func (v *VBytes) Decode(args ...Value) (Value, *Closure) {
+	defer Traceback("b.decode", args)
+	enc := encoding(ProcArg(args, 0, NilValue))
+	return Return(decode(v.b, enc))
+}
+
+// byteVal(x) returns x as a byte, or panics if out of range
+func byteVal(x Value) byte {
+	n := IntVal(x)
+	if n < 0 || n > 255 {
+		panic(NewErr(ErrByteVal, x))
+	}
+	return byte(n)
+}
+
+// BytesVal(x) returns x as a VBytes value, or panics
+func BytesVal(x Value) *VBytes {
+	if b, ok := x.(*VBytes); ok {
+		return b
+	}
+	panic(NewErr(ErrBytes, x))
+}
+
+// encoding(x) returns the canonical name of the encoding x, or panics.
+// Case, hyphens, and underscores are ignored; nil means "utf-8".
+func encoding(x Value) string {
+	if x == NilValue {
+		return "utf8"
+	}
+	e := strings.ToLower(ToString(x).ToUTF8())
+	e = strings.NewReplacer("-", "", "_", "").Replace(e)
+	switch e {
+	case "utf8", "ascii", "utf16be", "utf16le":
+		return e
+	case "latin1", "iso88591":
+		return "latin1"
+	default:
+		panic(NewErr(ErrEncoding, x))
+	}
+}
+
+// encode(s, enc) returns the bytes encoding s using canonical encoding enc
+func encode(s *VString, enc string) []byte {
+	switch enc {
+	case "utf8":
+		return []byte(s.ToUTF8())
+	case "latin1", "ascii":
+		max := rune(0xFF)
+		if enc == "ascii" {
+			max = 0x7F
+		}
+		r := s.ToRunes()
+		b := make([]byte, len(r))
+		for i, c := range r {
+			if c > max {
+				panic(NewErr(ErrCharCode, RuneString(r[i:i+1])))
+			}
+			b[i] = byte(c)
+		}
+		return b
+	default: // UTF-16
+		u := utf16.Encode(s.ToRunes())
+		b := make([]byte, 2*len(u))
+		for i, c := range u {
+			if enc == "utf16be" {
+				b[2*i], b[2*i+1] = byte(c>>8), byte(c)
+			} else {
+				b[2*i], b[2*i+1] = byte(c), byte(c>>8)
+			}
+		}
+		return b
+	}
+}
+
+// decode(b, enc) returns the string encoded by b using canonical encoding enc
+func decode(b []byte, enc string) *VString {
+	switch enc {
+	case "utf8":
+		return NewString(string(b))
+	case "latin1", "ascii":
+		r := make([]rune, len(b))
+		for i, c := range b {
+			r[i] = rune(c)
+			if enc == "ascii" && c > 0x7F {
+				r[i] = utf8.RuneError
+			}
+		}
+		return RuneString(r)
+	default: // UTF-16
+		u := make([]uint16, len(b)/2)
+		for i := range u {
+			if enc == "utf16be" {
+				u[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+			} else {
+				u[i] = uint16(b[2*i]) | uint16(b[2*i+1])<<8
+			}
+		}
+		r := utf16.Decode(u)
+		if len(b)%2 != 0 {
+			r = append(r, utf8.RuneError) // odd trailing byte
+		}
+		return RuneString(r)
+	}
+}
+
+// VBytes.String -- default conversion to Go string returns the raw bytes,
+// so that writing a bytes value outputs its bytes unchanged
+func (v *VBytes) String() string {
+	return string(v.b)
+}
+
+// VBytes.GoString -- convert to Go string for image() and printf("%#v")
+func (v *VBytes) GoString() string {
+	return "bytes(" + strconv.Quote(string(v.b)) + ")"
+}
+
+// VBytes.Type -- return the bytes type
+func (v *VBytes) Type() IRank {
+	return BytesType
+}
+
+// VBytes.Copy returns itself
+func (v *VBytes) Copy() Value {
+	return v
+}
+
+// VBytes.Before compares two bytes values for sorting
+func (a *VBytes) Before(b Value, i int) bool {
+	return string(a.b) < string(b.(*VBytes).b)
+}
+
+// VBytes.Identical -- check equality for === operator
+func (a *VBytes) Identical(x Value) Value {
+	if b, ok := x.(*VBytes); ok && string(a.b) == string(b.b) {
+		return x
+	} else {
+		return nil
+	}
+}
+
+// VBytes.Import returns itself
+func (v *VBytes) Import() Value {
+	return v
+}
+
+// VBytes.Export returns a copy as a Go []byte
+func (v *VBytes) Export() interface{} {
+	c := make([]byte, len(v.b))
+	copy(c, v.b)
+	return c
+}
+
+// bytesKey is the Go map key for a VBytes (see GoKey)
+type bytesKey string
+
+// bytesKey.Import converts a map key back into a VBytes
+func (k bytesKey) Import() Value {
+	return &VBytes{[]byte(string(k)), nil}
+}
@@ -0,0 +1,337 @@
+//  vcollate.go -- VCollator, the Goaldi type "collator"
+//
+//  A collator orders strings by the Unicode Collation Algorithm,
+//  using the Default Unicode Collation Element Table of ucadata.go
+//  with a few simple tailorings for particular languages.
+//  The table is generated by mkucadata.py; see doc/build.adoc.
+//  Strings are compared at one to three levels of strength:
+//  base letters, then accents, then case and variant forms.
+//  All characters are treated as non-ignorable.
+
+package runtime
+
+//go:generate sh -c "python3.9 mkucadata.py $ALLKEYS >ucadata.new && gofmt ucadata.new >ucadata.go; rm -f ucadata.new"
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// VCollator is a set of rules for ordering strings
+type VCollator struct {
+	locale   string               // language code, or "root"
+	strength int                  // number of levels compared
+	tailor   map[string][]colElem // tailored elements, by NFD sequence
+}
+
+// colElem is a collation element with primary weights scaled by 256
+// to leave room for tailored primaries
+type colElem struct {
+	p    uint32 // primary weight
+	s, t uint16 // secondary and tertiary weights
+}
+
+// ucaRange gives the elements of a range of characters.  If n is zero,
+// each character has a single element v, with its primary weight (if
+// nonzero) increased by the character's offset in the range.
+// Otherwise the range has one character whose n elements begin at
+// ucaExpansions[v].
+type ucaRange struct {
+	lo, hi rune
+	v      uint32
+	n      uint8
+}
+
+// ucaContraction gives the elements of a sequence of characters
+type ucaContraction struct {
+	s    string // characters
+	i, n int    // elements are ucaExpansions[i:i+n]
+}
+
+// ucaBlock is a block of characters with its own implicit weights
+type ucaBlock struct {
+	lo, hi rune
+	base   uint32
+}
+
+const maxContraction = 3 // length of longest contraction
+
+const rCollator = 74        // declare sort ranking
+var _ ICore = &VCollator{}  // validate implementation
+var _ IImage = &VCollator{} // validate implementation
+var _ ICall = &VCollator{}  // validate implementation
+
+// NewCollator -- construct a collator for a language and strength
+func NewCollator(locale string, strength int) *VCollator {
+	lang := strings.ToLower(locale)
+	if i := strings.IndexAny(lang, "_-.@"); i >= 0 {
+		lang = lang[:i]
+	}
+	t := collTailoring(lang)
+	if t == nil { // no tailoring: use the root ordering, and say so
+		lang = "root"
+	}
+	return &VCollator{lang, strength, t}
+}
+
+// CollatorType is the collator instance of type type.
+var CollatorType = NewType("collator", "C", rCollator, Collate, CollatorMethods,
+	"collate", "locale,strength", "create string collator")
+
+// VCollator.String -- default conversion to Go string returns "C:locale"
+func (C *VCollator) String() string {
+	return "C:" + C.locale
+}
+
+// VCollator.GoString -- convert to Go string for image() and printf("%#v")
+func (C *VCollator) GoString() string {
+	return C.Image(1)
+}
+
+// VCollator.Image -- return image showing locale and strength
+func (C *VCollator) Image(d int) string {
+	return fmt.Sprintf("collator(%q,%d)", C.locale, C.strength)
+}
+
+// VCollator.Type -- return the collator type
+func (C *VCollator) Type() IRank {
+	return CollatorType
+}
+
+// VCollator.Copy returns itself
+func (C *VCollator) Copy() Value {
+	return C
+}
+
+// VCollator.Before compares two collators for sorting
+func (C *VCollator) Before(b Value, i int) bool {
+	return false // no ordering defined
+}
+
+// VCollator.Import returns itself
+func (C *VCollator) Import() Value {
+	return C
+}
+
+// VCollator.Export returns itself
+func (C *VCollator) Export() interface{} {
+	return C
+}
+
+// VCollator.Call(a, b) succeeds, returning b, if a sorts before b
+func (C *VCollator) Call(env *Env, args []Value, names []string) (Value, *Closure) {
+	if len(names) > 0 {
+		panic(NewErr(ErrNamedArgs, C))
+	}
+	b := ProcArg(args, 1, NilValue)
+	if C.compare(ToString(ProcArg(args, 0, NilValue)), ToString(b)) < 0 {
+		return Return(b)
+	}
+	return Fail()
+}
+
+//  -------------------------- tailoring ---------------------
+
+// collTailorings gives, for each language, the letters that follow
+// a base letter as separate letters of the alphabet, in order.
+// Letters grouped together differ only as accented forms do,
+// and each letter has its uppercase form as a tertiary variant.
+var collTailorings = map[string]struct {
+	base    rune
+	letters string
+}{
+	"da": {'z', "æä øö å"},
+	"es": {'n', "ñ"},
+	"fi": {'z', "å äæ öø"},
+	"nb": {'z', "æä øö å"},
+	"nn": {'z', "æä øö å"},
+	"no": {'z', "æä øö å"},
+	"sv": {'z', "å äæ öø"},
+}
+
+// collTailoring(lang) returns the tailored elements for language lang,
+// or nil if it uses the default table
+func collTailoring(lang string) map[string][]colElem {
+	r, ok := collTailorings[lang]
+	if !ok {
+		return nil
+	}
+	m := make(map[string][]colElem)
+	p := rootElements(r.base)[0].p
+	for _, g := range strings.Fields(r.letters) {
+		p++
+		s := uint16(0x20)
+		for _, c := range g {
+			for _, x := range []rune{c, unicode.ToUpper(c)} {
+				d := decompose([]rune{x}, false)
+				m[string(d)] = []colElem{{p, s, rootElements(d[0])[0].t}}
+			}
+			s++
+		}
+	}
+	return m
+}
+
+//  -------------------------- collation elements ---------------------
+
+// ucaContract maps each contraction to its elements
+var ucaContract = make(map[string][]colElem)
+
+func init() {
+	for _, k := range ucaContractions {
+		ucaContract[k.s] = expansion(k.i, k.n)
+	}
+}
+
+// unpack(v) returns the packed element v with its primary scaled
+func unpack(v uint32) colElem {
+	return colElem{v >> 16 << 8, uint16(v >> 5 & 0x1FF), uint16(v & 0x1F)}
+}
+
+// expansion(i, n) returns the n elements at ucaExpansions[i]
+func expansion(i int, n int) []colElem {
+	e := make([]colElem, n)
+	for j := range e {
+		e[j] = unpack(ucaExpansions[i+j])
+	}
+	return e
+}
+
+// rootElements(c) returns the default elements of character c,
+// or nil if c is not in the table
+func rootElements(c rune) []colElem {
+	i := sort.Search(len(ucaRanges), func(i int) bool {
+		return ucaRanges[i].hi >= c
+	})
+	if i == len(ucaRanges) || ucaRanges[i].lo > c {
+		return nil
+	}
+	r := ucaRanges[i]
+	if r.n > 0 {
+		return expansion(int(r.v), int(r.n))
+	}
+	e := unpack(r.v)
+	if e.p != 0 {
+		e.p += uint32(c-r.lo) << 8
+	}
+	return []colElem{e}
+}
+
+// implicitElements(c) returns the computed elements of a character
+// that is not in the table
+func implicitElements(c rune) []colElem {
+	var base, rest uint32
+	for _, b := range ucaImplicit {
+		if c >= b.lo && c <= b.hi {
+			base, rest = b.base, uint32(c-b.lo)
+		}
+	}
+	if base == 0 {
+		switch {
+		case !unicode.Is(unicode.Unified_Ideograph, c):
+			base = 0xFBC0
+		case c >= 0x4E00 && c <= 0x9FFF, c >= 0xF900 && c <= 0xFAFF:
+			base = 0xFB40
+		default:
+			base = 0xFB80
+		}
+		base += uint32(c >> 15)
+		rest = uint32(c & 0x7FFF)
+	}
+	return []colElem{{base << 8, 0x20, 0x02}, {(rest | 0x8000) << 8, 0, 0}}
+}
+
+// VCollator.find(s) returns the elements of the character sequence s,
+// if it is listed in the tailoring or the default table
+func (C *VCollator) find(s []rune) ([]colElem, bool) {
+	if e, ok := C.tailor[string(s)]; ok {
+		return e, true
+	}
+	if len(s) == 1 {
+		e := rootElements(s[0])
+		return e, e != nil
+	}
+	e, ok := ucaContract[string(s)]
+	return e, ok
+}
+
+// VCollator.elements(s) returns the collation elements of string s
+func (C *VCollator) elements(s []rune) []colElem {
+	r := decompose(s, false)
+	var e []colElem
+	for len(r) > 0 {
+		// find the longest listed sequence at the front
+		n := maxContraction
+		if n > len(r) {
+			n = len(r)
+		}
+		var x []colElem
+		ok := false
+		for ; n > 0 && !ok; n-- {
+			x, ok = C.find(r[:n])
+		}
+		n++
+		if !ok {
+			x = implicitElements(r[0])
+		}
+		// extend it with unblocked combining marks that follow
+		m := append([]rune{}, r[:n]...)
+		r = r[n:]
+		var last uint8
+		for j := 0; j < len(r); {
+			cc := combiningClass(r[j])
+			if cc == 0 {
+				break
+			}
+			if cc != last {
+				if y, ok := C.find(append(m, r[j])); ok {
+					m, x = append(m, r[j]), y
+					r = append(r[:j], r[j+1:]...)
+					continue
+				}
+			}
+			last = cc
+			j++
+		}
+		e = append(e, x...)
+	}
+	return e
+}
+
+// VCollator.key(s) returns the sort key of string s, which orders
+// bytewise as s orders under the collator
+func (C *VCollator) key(s *VString) []byte {
+	e := C.elements(s.ToRunes())
+	k := make([]byte, 0, 6*len(e)+5)
+	for _, x := range e {
+		if x.p != 0 {
+			k = append(k, byte(x.p>>16), byte(x.p>>8), byte(x.p))
+		}
+	}
+	if C.strength >= 2 {
+		k = append(k, 0, 0, 0)
+		for _, x := range e {
+			if x.s != 0 {
+				k = append(k, byte(x.s>>8), byte(x.s))
+			}
+		}
+	}
+	if C.strength >= 3 {
+		k = append(k, 0, 0)
+		for _, x := range e {
+			if x.t != 0 {
+				k = append(k, byte(x.t))
+			}
+		}
+	}
+	return k
+}
+
+// VCollator.compare(a, b) returns -1, 0, or +1 as a sorts before,
+// with, or after b
+func (C *VCollator) compare(a *VString, b *VString) int {
+	return bytes.Compare(C.key(a), C.key(b))
+}
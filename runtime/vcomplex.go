@@ -0,0 +1,167 @@
+//  vcomplex.go -- VComplex, the Goaldi type "complex"
+//
+//  A complex number has real and imaginary parts that are 64-bit
+//  floating-point values.  Complex numbers combine with other numbers
+//  in arithmetic to give complex results, but they are not ordered,
+//  so they cannot be compared except for equality.
+
+package runtime
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+type VComplex complex128
+
+// NewComplex -- construct a Goaldi complex number from a complex128 value
+func NewComplex(c complex128) *VComplex {
+	vc := VComplex(c)
+	return &vc
+}
+
+const rComplex = 15              // declare sort ranking
+var _ ICore = NewComplex(0)      // validate implementation
+var _ Stringable = NewComplex(0) // validate implementation
+
+// ComplexType is the complex instance of type type.
+var ComplexType = NewType("complex", "k", rComplex, Complex, ComplexMethods,
+	"complex", "x,y", "convert to complex")
+
+// Declare methods
+var ComplexMethods = MethodTable([]*VProcedure{
+	DefMeth((*VComplex).Real, "real", "", "return real part"),
+	DefMeth((*VComplex).Imag, "imag", "", "return imaginary part"),
+	DefMeth((*VComplex).Conj, "conj", "", "return complex conjugate"),
+	DefMeth((*VComplex).Phase, "phase", "", "return phase angle"),
+})
+
+// complex(x, y) returns the complex number x + yi.
+// The default value of y is 0.
+// If y is omitted, x may be a string of a form such as "3+4i",
+// "-2.5i", or "(1-1i)", or a number of any type.
+// complex() fails if its arguments cannot be converted.
+func Complex(env *Env, args ...Value) (Value, *Closure) {
+	// nonstandard entry; on panic, returns default nil values to fail
+	defer func() { recover() }()
+	x := ProcArg(args, 0, NilValue)
+	y := ProcArg(args, 1, NilValue)
+	if y == NilValue {
+		if s, ok := x.(*VString); ok {
+			t := strings.Trim(s.ToUTF8(), " \t")
+			if c, err := strconv.ParseComplex(t, 128); err == nil {
+				return Return(NewComplex(c))
+			}
+		}
+		return Return(NewComplex(cval(numval(x))))
+	}
+	return Return(NewComplex(complex(fval(numval(x)), fval(numval(y)))))
+}
+
+// cval(n) returns the value of number n as a complex128
+func cval(n Value) complex128 {
+	if c, ok := n.(*VComplex); ok {
+		return c.Val()
+	}
+	return complex(fval(n), 0)
+}
+
+// VComplex.Val -- return underlying complex128 value
+func (v *VComplex) Val() complex128 {
+	return complex128(*v)
+}
+
+// cstring(c, f) formats c as "a+bi" using f to format each part
+func cstring(c complex128, f func(float64) string) string {
+	re := f(real(c))
+	im := f(imag(c))
+	if im[0] != '-' && im[0] != '+' {
+		im = "+" + im
+	}
+	return re + im + "i"
+}
+
+// VComplex.String -- default conversion to Go string, e.g. "3+4i"
+func (v *VComplex) String() string {
+	return cstring(v.Val(), func(f float64) string {
+		return NewNumber(f).String()
+	})
+}
+
+// VComplex.GoString -- convert to Go string for image() and printf("%#v")
+// The difference vs String() is that all significant digits are returned
+func (v *VComplex) GoString() string {
+	return "complex(" + cstring(v.Val(), func(f float64) string {
+		return NewNumber(f).GoString()
+	}) + ")"
+}
+
+// VComplex.ToString -- convert to Goaldi string
+func (v *VComplex) ToString() *VString {
+	return NewString(v.String())
+}
+
+// VComplex.Type -- return the complex type
+func (v *VComplex) Type() IRank {
+	return ComplexType
+}
+
+// VComplex.Copy returns itself
+func (v *VComplex) Copy() Value {
+	return v
+}
+
+// VComplex.Before orders complex numbers for sorting
+// by real part and then by imaginary part
+func (a *VComplex) Before(b Value, i int) bool {
+	c := b.(*VComplex).Val()
+	if real(a.Val()) != real(c) {
+		return real(a.Val()) < real(c)
+	}
+	return imag(a.Val()) < imag(c)
+}
+
+// VComplex.Identical -- check equality for === operator
+func (a *VComplex) Identical(x Value) Value {
+	if b, ok := x.(*VComplex); ok && a.Val() == b.Val() {
+		return x
+	} else {
+		return nil
+	}
+}
+
+// VComplex.Import returns itself
+func (v *VComplex) Import() Value {
+	return v
+}
+
+// VComplex.Export returns a complex128
+func (v *VComplex) Export() interface{} {
+	return complex128(*v)
+}
+
+// k.real() returns the real part of the complex number k.
+func (v *VComplex) Real(args ...Value) (Value, *Closure) {
+	defer Traceback("k.real", args)
+	return Return(NewNumber(real(v.Val())))
+}
+
+// k.imag() returns the imaginary part of the complex number k.
+func (v *VComplex) Imag(args ...Value) (Value, *Closure) {
+	defer Traceback("k.imag", args)
+	return Return(NewNumber(imag(v.Val())))
+}
+
+// k.conj() returns the complex conjugate of the complex number k.
+func (v *VComplex) Conj(args ...Value) (Value, *Closure) {
+	defer Traceback("k.conj", args)
+	return Return(NewComplex(complex(real(v.Val()), -imag(v.Val()))))
+}
+
+// k.phase() returns the phase angle of the complex number k,
+// in radians, in the range [-%pi, %pi].
+func (v *VComplex) Phase(args ...Value) (Value, *Closure) {
+	defer Traceback("k.phase", args)
+	return Return(NewNumber(math.Atan2(imag(v.Val()), real(v.Val()))))
+}
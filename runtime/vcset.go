@@ -0,0 +1,320 @@
+//  vcset.go -- VCset, the Goaldi type "cset"
+//
+//  A cset is an immutable set of Unicode characters, represented as
+//  an ordered list of disjoint, nonadjacent ranges of code points.
+//  This keeps large sets such as Unicode categories compact.
+
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"unicode"
+)
+
+// A crange is an inclusive range of code points.
+type crange struct {
+	lo, hi rune
+}
+
+// A cset is an ordered list of ranges.
+type VCset struct {
+	r []crange // disjoint and nonadjacent, in increasing order
+}
+
+const rCset = 25            // declare sort ranking
+var _ ICore = &VCset{}      // validate implementation
+var _ Stringable = &VCset{} // validate implementation
+var _ IIdentical = &VCset{} // validate implementation
+
+// CsetType is the cset instance of type type.
+var CsetType = NewType("cset", "a", rCset, Cset, nil,
+	"cset", "x[]", "create character set")
+
+// predefined csets
+var (
+	ASCII   = csetRange(0, 127)
+	DIGITS  = csetRange('0', '9')
+	CLCASE  = csetRange('a', 'z')
+	CUCASE  = csetRange('A', 'Z')
+	LETTERS = CUCASE.union(CLCASE)
+)
+
+func init() {
+	DefLib(Category, "category", "name", "return cset of Unicode category")
+	EnvInit("ascii", ASCII)
+	EnvInit("digits", DIGITS)
+	EnvInit("lcase", CLCASE)
+	EnvInit("ucase", CUCASE)
+	EnvInit("letters", LETTERS)
+}
+
+// cset(x, ...) returns the set of all characters in its arguments,
+// each of which may be a cset or a string.
+func Cset(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("cset", args)
+	c := &VCset{}
+	for _, x := range args {
+		c = c.union(CsetVal(x))
+	}
+	return Return(c)
+}
+
+// category(name) returns a cset of the characters in a Unicode category
+// such as "L" (letters), "Lu" (upper case letters), or "Nd" (decimal digits),
+// a Unicode script such as "Greek" or "Han",
+// or a Unicode property such as "White_Space".
+// It fails if the name is not recognized.
+func Category(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("category", args)
+	name := ToString(ProcArg(args, 0, NilValue)).ToUTF8()
+	for _, m := range []map[string]*unicode.RangeTable{
+		unicode.Categories, unicode.Scripts, unicode.Properties} {
+		if t := m[name]; t != nil {
+			return Return(NewCsetTable(t))
+		}
+	}
+	return Fail()
+}
+
+// CsetVal(x) returns x as a cset, converting a string if necessary
+func CsetVal(x Value) *VCset {
+	if c, ok := x.(*VCset); ok {
+		return c
+	}
+	return NewCset(ToString(x).ToRunes())
+}
+
+// NewCset(s) -- construct a cset from the characters of s
+func NewCset(s []rune) *VCset {
+	r := make([]crange, len(s))
+	for i, c := range s {
+		r[i] = crange{c, c}
+	}
+	return normalize(r)
+}
+
+// NewCsetTable(t) -- construct a cset from a Unicode range table
+func NewCsetTable(t *unicode.RangeTable) *VCset {
+	r := make([]crange, 0)
+	for _, x := range t.R16 {
+		for c := rune(x.Lo); c <= rune(x.Hi); c += rune(x.Stride) {
+			if x.Stride == 1 {
+				r = append(r, crange{c, rune(x.Hi)})
+				break
+			}
+			r = append(r, crange{c, c})
+		}
+	}
+	for _, x := range t.R32 {
+		for c := rune(x.Lo); c <= rune(x.Hi); c += rune(x.Stride) {
+			if x.Stride == 1 {
+				r = append(r, crange{c, rune(x.Hi)})
+				break
+			}
+			r = append(r, crange{c, c})
+		}
+	}
+	return normalize(r)
+}
+
+// csetRange(lo, hi) -- construct a cset of a single range
+func csetRange(lo rune, hi rune) *VCset {
+	return &VCset{[]crange{{lo, hi}}}
+}
+
+// normalize(r) sorts and merges ranges to make a cset
+func normalize(r []crange) *VCset {
+	sort.Slice(r, func(i, j int) bool { return r[i].lo < r[j].lo })
+	n := 0
+	for _, x := range r {
+		if n > 0 && x.lo <= r[n-1].hi+1 {
+			if x.hi > r[n-1].hi {
+				r[n-1].hi = x.hi
+			}
+		} else {
+			r[n] = x
+			n++
+		}
+	}
+	return &VCset{r[:n]}
+}
+
+// VCset.Has(c) reports whether character c is in the cset
+func (v *VCset) Has(c rune) bool {
+	i := sort.Search(len(v.r), func(i int) bool { return v.r[i].hi >= c })
+	return i < len(v.r) && v.r[i].lo <= c
+}
+
+// VCset.size -- return the number of characters in the cset
+func (v *VCset) size() int {
+	n := 0
+	for _, x := range v.r {
+		n += int(x.hi-x.lo) + 1
+	}
+	return n
+}
+
+// VCset.union(w) -- return the union of two csets
+func (v *VCset) union(w *VCset) *VCset {
+	r := make([]crange, 0, len(v.r)+len(w.r))
+	r = append(append(r, v.r...), w.r...)
+	return normalize(r)
+}
+
+// VCset.intersect(w) -- return the intersection of two csets
+func (v *VCset) intersect(w *VCset) *VCset {
+	r := make([]crange, 0)
+	for i, j := 0, 0; i < len(v.r) && j < len(w.r); {
+		a, b := v.r[i], w.r[j]
+		lo, hi := a.lo, a.hi
+		if b.lo > lo {
+			lo = b.lo
+		}
+		if b.hi < hi {
+			hi = b.hi
+		}
+		if lo <= hi {
+			r = append(r, crange{lo, hi})
+		}
+		if a.hi < b.hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return &VCset{r}
+}
+
+// VCset.complement() -- return the cset of all characters not in v
+func (v *VCset) complement() *VCset {
+	r := make([]crange, 0, len(v.r)+1)
+	lo := rune(0)
+	for _, x := range v.r {
+		if x.lo > lo {
+			r = append(r, crange{lo, x.lo - 1})
+		}
+		lo = x.hi + 1
+	}
+	if lo <= unicode.MaxRune {
+		r = append(r, crange{lo, unicode.MaxRune})
+	}
+	return &VCset{r}
+}
+
+// VCset.ToRunes -- return the characters of the cset in order
+func (v *VCset) ToRunes() []rune {
+	s := make([]rune, 0, v.size())
+	for _, x := range v.r {
+		for c := x.lo; c <= x.hi; c++ {
+			s = append(s, c)
+		}
+	}
+	return s
+}
+
+// VCset.String -- default conversion to Go string returns the characters
+func (v *VCset) String() string {
+	return string(v.ToRunes())
+}
+
+// VCset.GoString -- convert to Go string for image() and printf("%#v").
+// Ranges of more than three characters are shown as 'a'-'z'
+// and other characters are collected into quoted strings.
+func (v *VCset) GoString() string {
+	var b bytes.Buffer
+	var pending []rune
+	sep := ""
+	flush := func() {
+		if len(pending) > 0 {
+			b.WriteString(sep + strconv.Quote(string(pending)))
+			pending = pending[:0]
+			sep = ","
+		}
+	}
+	b.WriteString("cset(")
+	for _, x := range v.r {
+		if x.hi-x.lo < 3 {
+			for c := x.lo; c <= x.hi; c++ {
+				pending = append(pending, c)
+			}
+		} else {
+			flush()
+			fmt.Fprintf(&b, "%s%s-%s", sep,
+				strconv.QuoteRune(x.lo), strconv.QuoteRune(x.hi))
+			sep = ","
+		}
+	}
+	flush()
+	b.WriteString(")")
+	return b.String()
+}
+
+// VCset.ToString -- convert to Goaldi string
+func (v *VCset) ToString() *VString {
+	return RuneString(v.ToRunes())
+}
+
+// VCset.Type -- return the cset type
+func (v *VCset) Type() IRank {
+	return CsetType
+}
+
+// VCset.Copy returns itself
+func (v *VCset) Copy() Value {
+	return v
+}
+
+// VCset.Before compares two csets for sorting
+// by comparing their characters in order
+func (a *VCset) Before(x Value, i int) bool {
+	b := x.(*VCset)
+	for k := 0; k < len(a.r) && k < len(b.r); k++ {
+		if a.r[k].lo != b.r[k].lo {
+			return a.r[k].lo < b.r[k].lo
+		}
+		if a.r[k].hi != b.r[k].hi {
+			// the longer range has the smaller next character,
+			// unless the shorter one is a prefix of the other
+			if a.r[k].hi < b.r[k].hi {
+				return k+1 >= len(a.r)
+			}
+			return k+1 < len(b.r)
+		}
+	}
+	return len(a.r) < len(b.r)
+}
+
+// VCset.Identical -- check equality for === operator
+func (a *VCset) Identical(x Value) Value {
+	b, ok := x.(*VCset)
+	if !ok || len(a.r) != len(b.r) {
+		return nil
+	}
+	for i := range a.r {
+		if a.r[i] != b.r[i] {
+			return nil
+		}
+	}
+	return x
+}
+
+// VCset.Import returns itself
+func (v *VCset) Import() Value {
+	return v
+}
+
+// VCset.Export returns itself
+func (v *VCset) Export() interface{} {
+	return v
+}
+
+// csetKey is the Go map key for a VCset (see GoKey)
+type csetKey string
+
+// csetKey.Import converts a map key back into a VCset
+func (k csetKey) Import() Value {
+	return NewCset([]rune(string(k)))
+}
@@ -41,7 +41,7 @@ func NewCtor(name string, parent *VCtor, newfields []string) *VCtor {
 	ctor := &VCtor{ctype, parent, fields, fmap}
 	for i, s := range fields {
 		if ctor.Methods[s] != nil || ctor.Fmap[s] != 0 {
-			panic(NewExn("Duplicate field name", s))
+			panic(NewErr(ErrDupField, s))
 		}
 		ctor.Fmap[s] = i + 1 // enter field-to-index mapping
 	}
@@ -218,7 +218,7 @@ func Constructor(env *Env, args ...Value) (Value, *Closure) {
 func Identifier(x Value) string {
 	s := ToString(x).ToUTF8()
 	if !idPattern.MatchString(s) {
-		panic(NewExn("Not an identifier", s))
+		panic(NewErr(ErrIdent, s))
 	}
 	return s
 }
@@ -0,0 +1,279 @@
+//  vdecimal.go -- VDecimal, the Goaldi type "decimal"
+//
+//  A decimal number is an integer of any size scaled by a fixed number
+//  of decimal places, as is suitable for representing currency.
+//  Arithmetic results keep the larger scale of the operands and are
+//  rounded to that scale using banker's rounding (round half to even);
+//  see onumber.go for the rules of mixed arithmetic.
+
+package runtime
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type VDecimal struct {
+	unscaled *big.Int // value times 10^scale
+	scale    int      // number of digits after the decimal point
+}
+
+// NewDecimal -- construct a Goaldi decimal by rounding r to s decimal places
+func NewDecimal(r *big.Rat, s int) *VDecimal {
+	n := new(big.Int).Mul(r.Num(), pow10(s))
+	return &VDecimal{roundHalfEven(n, r.Denom()), s}
+}
+
+const rDecimal = rNumber                         // sorts along with numbers
+var _ ICore = &VDecimal{new(big.Int), 0}         // validate implementation
+var _ Numerable = &VDecimal{new(big.Int), 0}     // validate implementation
+var _ Stringable = &VDecimal{new(big.Int), 0}    // validate implementation
+var _ fmt.Formatter = &VDecimal{new(big.Int), 0} // validate implementation
+
+// DecimalType is the decimal instance of type type.
+var DecimalType = NewType("decimal", "d", rDecimal, Decimal, nil,
+	"decimal", "x,s", "convert to decimal")
+
+// decimal(x, s) converts x to a decimal value with s digits after the
+// decimal point, rounding as necessary using banker's rounding.
+// x may be a number, a rational, a decimal, or a string such as "19.95".
+// If s is omitted, the scale is the number of digits needed to
+// represent x exactly: for example, decimal("19.950") has scale 3.
+// decimal() fails if x cannot be converted, if s is negative,
+// or if s is omitted and x is a rational with no exact decimal form.
+func Decimal(env *Env, args ...Value) (Value, *Closure) {
+	// nonstandard entry; on panic, returns default nil values to fail
+	defer func() { recover() }()
+	x := ProcArg(args, 0, NilValue)
+	r := toRat(x)
+	s := 0
+	if a := ProcArg(args, 1, NilValue); a != NilValue {
+		s = IntVal(a)
+	} else if t, ok := x.(*VString); ok {
+		s = strScale(strings.Trim(t.ToUTF8(), " \t"))
+	} else {
+		s = decscale(numval(x))
+	}
+	if s < 0 {
+		return Fail()
+	}
+	return Return(NewDecimal(r, s))
+}
+
+// decscale(n) returns the number of decimal places needed for number n,
+// or -1 if it has no exact decimal representation
+func decscale(n Value) int {
+	switch v := n.(type) {
+	case *VDecimal:
+		return v.scale
+	case *VBigInt:
+		return 0
+	case *VRational:
+		// count the factors of 2 and 5 in the denominator
+		d := new(big.Int).Set(v.Rat().Denom())
+		twos := 0
+		fives := 0
+		for d.Bit(0) == 0 {
+			d.Rsh(d, 1)
+			twos++
+		}
+		five := big.NewInt(5)
+		m := new(big.Int)
+		for {
+			q, r := new(big.Int).QuoRem(d, five, m)
+			if r.Sign() != 0 {
+				break
+			}
+			d = q
+			fives++
+		}
+		if d.Cmp(big.NewInt(1)) != 0 {
+			return -1
+		}
+		if twos > fives {
+			return twos
+		}
+		return fives
+	default:
+		return strScale(strconv.FormatFloat(fval(n), 'f', -1, 64))
+	}
+}
+
+// strScale(s) returns the number of decimal places written in number
+// string s, allowing for any exponent
+func strScale(s string) int {
+	e := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, _ = strconv.Atoi(s[i+1:])
+		s = s[:i]
+	}
+	n := 0
+	if i := strings.Index(s, "."); i >= 0 {
+		n = len(s) - i - 1
+	}
+	if n -= e; n < 0 {
+		n = 0
+	}
+	return n
+}
+
+// pow10(n) returns 10^n as a big.Int
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundHalfEven(n, d) returns n / d rounded to the nearest integer,
+// choosing the even neighbor when exactly halfway
+func roundHalfEven(n *big.Int, d *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	r2 := new(big.Int).Abs(r)
+	c := r2.Lsh(r2, 1).Cmp(new(big.Int).Abs(d)) // compare 2|r| with |d|
+	if c > 0 || (c == 0 && q.Bit(0) == 1) {
+		if (n.Sign() < 0) != (d.Sign() < 0) {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// VDecimal.Rat -- return the exact value as a new big.Rat
+func (v *VDecimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(v.unscaled, pow10(v.scale))
+}
+
+// VDecimal.Scale -- return the number of digits after the decimal point
+func (v *VDecimal) Scale() int {
+	return v.scale
+}
+
+// VDecimal.String -- default conversion to Go string, e.g. "19.95"
+func (v *VDecimal) String() string {
+	s := new(big.Int).Abs(v.unscaled).String()
+	if v.scale > 0 {
+		if len(s) <= v.scale {
+			s = strings.Repeat("0", v.scale-len(s)+1) + s
+		}
+		s = s[:len(s)-v.scale] + "." + s[len(s)-v.scale:]
+	}
+	if v.unscaled.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// VDecimal.GoString -- convert to Go string for image() and printf("%#v")
+func (v *VDecimal) GoString() string {
+	return "decimal(" + v.String() + ")"
+}
+
+// VDecimal.Format -- implement fmt.Formatter for sprintf().
+// %v and %s give the decimal string; %f gives the value rounded to
+// the given precision, if any; other verbs format the nearest float.
+func (v *VDecimal) Format(f fmt.State, c rune) {
+	var s string
+	switch c {
+	case 'v':
+		if f.Flag('#') {
+			s = v.GoString()
+		} else {
+			s = v.String()
+		}
+	case 's':
+		s = v.String()
+	case 'f', 'F':
+		if p, ok := f.Precision(); ok {
+			s = NewDecimal(v.Rat(), p).String()
+		} else {
+			s = v.String()
+		}
+		if f.Flag('+') && v.unscaled.Sign() >= 0 {
+			s = "+" + s
+		}
+	default:
+		fmt.Fprintf(f, fmtSpec(f, c, true), v.ToNumber().Val())
+		return
+	}
+	fmt.Fprintf(f, fmtSpec(f, 's', false), s)
+}
+
+// fmtSpec(f, c, p) reconstructs a format specification with verb c,
+// including precision only if p is set
+func fmtSpec(f fmt.State, c rune, p bool) string {
+	s := "%"
+	for _, flag := range "-+# 0" {
+		if f.Flag(int(flag)) && (p || flag == '-') {
+			s += string(flag)
+		}
+	}
+	if w, ok := f.Width(); ok {
+		s += strconv.Itoa(w)
+	}
+	if prec, ok := f.Precision(); ok && p {
+		s += "." + strconv.Itoa(prec)
+	}
+	return s + string(c)
+}
+
+// VDecimal.ToString -- convert to Goaldi string
+func (v *VDecimal) ToString() *VString {
+	return NewString(v.String())
+}
+
+// VDecimal.ToNumber -- convert to nearest VNumber
+func (v *VDecimal) ToNumber() *VNumber {
+	f, _ := v.Rat().Float64()
+	return NewNumber(f)
+}
+
+// VDecimal.Type -- return the decimal type
+func (v *VDecimal) Type() IRank {
+	return DecimalType
+}
+
+// VDecimal.Copy returns itself
+func (v *VDecimal) Copy() Value {
+	return v
+}
+
+// VDecimal.Before compares two numbers for sorting
+func (a *VDecimal) Before(b Value, i int) bool {
+	return numCompare(a, b) < 0
+}
+
+// VDecimal.Identical -- check equality for === operator.
+// Decimals are identical only if they have the same value and scale.
+func (a *VDecimal) Identical(x Value) Value {
+	if b, ok := x.(*VDecimal); ok &&
+		a.scale == b.scale && a.unscaled.Cmp(b.unscaled) == 0 {
+		return x
+	} else {
+		return nil
+	}
+}
+
+// VDecimal.Import returns itself
+func (v *VDecimal) Import() Value {
+	return v
+}
+
+// VDecimal.Export returns itself, having no standard Go equivalent
+func (v *VDecimal) Export() interface{} {
+	return v
+}
+
+// decKey is the Go map key for a VDecimal (see GoKey)
+type decKey string
+
+// decKey.Import converts a map key back into a VDecimal
+func (k decKey) Import() Value {
+	s := string(k)
+	r, _ := new(big.Rat).SetString(s)
+	return NewDecimal(r, strScale(s))
+}
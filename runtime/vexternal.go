@@ -8,6 +8,7 @@ package runtime
 import (
 	"fmt"
 	"io"
+	"math/big"
 	"reflect"
 )
 
@@ -57,7 +58,7 @@ func Import(x interface{}) Value {
 	case string:
 		return NewString(v)
 	case []byte:
-		return NewString(string(v))
+		return NewBytes(v)
 	case []rune:
 		return RuneString(v)
 
@@ -66,7 +67,7 @@ func Import(x interface{}) Value {
 	case float64:
 		return NewNumber(float64(v))
 	case int:
-		return NewNumber(float64(v))
+		return importInt(int64(v))
 	case int8:
 		return NewNumber(float64(v))
 	case int16:
@@ -74,9 +75,9 @@ func Import(x interface{}) Value {
 	case int32:
 		return NewNumber(float64(v))
 	case int64:
-		return NewNumber(float64(v))
+		return importInt(v)
 	case uint:
-		return NewNumber(float64(v))
+		return importUint(uint64(v))
 	case uint8:
 		return NewNumber(float64(v))
 	case uint16:
@@ -84,9 +85,21 @@ func Import(x interface{}) Value {
 	case uint32:
 		return NewNumber(float64(v))
 	case uint64:
-		return NewNumber(float64(v))
+		return importUint(v)
 	case uintptr:
-		return NewNumber(float64(v))
+		return importUint(uint64(v))
+	case *big.Int:
+		return NewInteger(new(big.Int).Set(v))
+	case big.Int:
+		return NewInteger(new(big.Int).Set(&v))
+	case *big.Rat:
+		return NewRational(new(big.Rat).Set(v))
+	case big.Rat:
+		return NewRational(new(big.Rat).Set(&v))
+	case complex64:
+		return NewComplex(complex128(v))
+	case complex128:
+		return NewComplex(v)
 
 	case io.Reader, io.Writer: // either reader or writer makes a file
 		r, _ := x.(io.Reader)
@@ -100,6 +113,22 @@ func Import(x interface{}) Value {
 	}
 }
 
+// importInt(i) imports a Go integer exactly
+func importInt(i int64) Value {
+	if i > MAX_EXACT || i < -MAX_EXACT {
+		return NewInteger(big.NewInt(i))
+	}
+	return NewNumber(float64(i))
+}
+
+// importUint(u) imports a Go unsigned integer exactly
+func importUint(u uint64) Value {
+	if u > MAX_EXACT {
+		return NewInteger(new(big.Int).SetUint64(u))
+	}
+	return NewNumber(float64(u))
+}
+
 // Export(v) returns the default Go representation of a Goaldi value
 func Export(v Value) interface{} {
 	if x, ok := v.(IExport); ok {
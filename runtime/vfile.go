@@ -11,6 +11,8 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"runtime"
+	"sync"
 )
 
 const rFile = 30                    // declare sort ranking
@@ -27,12 +29,26 @@ var (
 		"%stderr", os.Stderr, nil, io.Writer(os.Stderr), os.Stderr)
 )
 
+// outputs of open files, tracked so that they can be flushed and closed
+// at shutdown.  The entries do not reference the files themselves, so
+// a file that is abandoned without being closed can still be collected;
+// its finalizer then flushes and closes it.
+var openFiles = make(map[*openOutput]bool)
+var openLock sync.Mutex
+
+// openOutput is the output side of a file that has not been closed
+type openOutput struct {
+	w io.Writer // writer, possibly buffered
+	c io.Closer // closer
+}
+
 type VFile struct {
 	Name     string      // name when opened
 	Original interface{} // underlying object (os.File? other Reader or Writer?)
 	Reader   io.Reader   // reader, if open for read
 	Writer   io.Writer   // writer, if open for write
 	Closer   io.Closer   // closer; the underlying file, if buffered
+	output   *openOutput // entry in openFiles, if tracked
 }
 
 var FileType = NewType("file", "f", rFile, File, FileMethods,
@@ -44,7 +60,60 @@ func NewFile(name string, file interface{},
 	if closer == nil { // need a closer; nil means already closed
 		closer = ioutil.NopCloser(reader)
 	}
-	return &VFile{name, file, reader, writer, closer}
+	v := &VFile{name, file, reader, writer, closer, nil}
+	if writer != nil {
+		p := &openOutput{writer, closer}
+		v.output = p
+		openLock.Lock()
+		openFiles[p] = true
+		openLock.Unlock()
+		runtime.SetFinalizer(v, func(*VFile) {
+			if p.untrack() {
+				p.finish()
+			}
+		})
+	}
+	return v
+}
+
+// CloseAll() flushes all open output files and closes all but the
+// standard ones.  It is called at shutdown.
+func CloseAll() {
+	openLock.Lock()
+	outputs := make([]*openOutput, 0, len(openFiles))
+	for p := range openFiles {
+		outputs = append(outputs, p)
+	}
+	openFiles = make(map[*openOutput]bool)
+	openLock.Unlock()
+	for _, p := range outputs {
+		p.finish()
+	}
+}
+
+// openOutput.untrack() removes p from the open files, reporting
+// whether it was there
+func (p *openOutput) untrack() bool {
+	openLock.Lock()
+	defer openLock.Unlock()
+	if !openFiles[p] {
+		return false
+	}
+	delete(openFiles, p)
+	return true
+}
+
+// openOutput.finish() flushes p and closes it unless it is standard output
+// or standard error
+func (p *openOutput) finish() {
+	if b, ok := p.w.(*bufio.Writer); ok {
+		b.Flush()
+	}
+	switch p.c {
+	case os.Stdin, os.Stdout, os.Stderr:
+	default:
+		p.c.Close()
+	}
 }
 
 // VFile.String -- conversion to Go string returns "f:name"
@@ -115,14 +184,14 @@ func (v *VFile) Read(p []byte) (int, error) {
 	if v.Reader != nil {
 		return v.Reader.Read(p)
 	} else {
-		panic(NewExn("Not open for reading", v))
+		panic(NewErr(ErrNotReader, v))
 	}
 }
 
 // VFile.ReadLine() returns the next line from this file, or nil at EOF.
 func (v *VFile) ReadLine() *VString {
 	if v.Reader == nil {
-		panic(NewExn("Not open for reading", v))
+		panic(NewErr(ErrNotReader, v))
 	}
 	var s string
 	var e error
@@ -171,7 +240,7 @@ func (v *VFile) Write(p []byte) (int, error) {
 	if v.Writer != nil {
 		return v.Writer.Write(p)
 	} else {
-		panic(NewExn("Not open for writing", v))
+		panic(NewErr(ErrNotWriter, v))
 	}
 }
 
@@ -195,7 +264,7 @@ func (v *VFile) Seek(offset int64, whence int) (int64, error) {
 		f, ok = v.Writer.(io.Seeker)
 	}
 	if !ok {
-		panic(NewExn("Not seekable", v))
+		panic(NewErr(ErrNotSeek, v))
 	}
 	return f.Seek(offset, whence)
 }
@@ -204,7 +273,7 @@ func (v *VFile) Seek(offset int64, whence int) (int64, error) {
 // It marks the file as closed and calls io.Close() on the underlying Closer.
 func (v *VFile) Close() error {
 	if v.Closer == nil {
-		panic(NewExn("File not open", v))
+		panic(NewErr(ErrNotOpen, v))
 	}
 	err := v.Flush()
 	if err != nil {
@@ -214,5 +283,10 @@ func (v *VFile) Close() error {
 	v.Reader = nil
 	v.Writer = nil
 	v.Closer = nil
+	if v.output != nil {
+		v.output.untrack()
+		v.output = nil
+		runtime.SetFinalizer(v, nil)
+	}
 	return c.Close()
 }
@@ -61,13 +61,18 @@ func (v *VList) String() string {
 
 // VList.GoString -- convert to Go string for image() and printf("%#v")
 func (v *VList) GoString() string {
+	return v.Image(1)
+}
+
+// VList.Image -- returns image with elements shown to depth d-1
+func (v *VList) Image(d int) string {
 	if len(v.data) == 0 {
 		return "[]"
 	}
 	var b bytes.Buffer
 	fmt.Fprintf(&b, "[")
 	for i := 0; i < len(v.data); i++ {
-		fmt.Fprintf(&b, "%v,", v.Elem(i))
+		fmt.Fprintf(&b, "%s,", ImageDepth(v.Elem(i), d-1))
 	}
 	s := b.Bytes()
 	s[len(s)-1] = ']'
@@ -122,7 +122,7 @@ func GetMethod(m map[string]*VProcedure, v Value, s string) *VMethVal {
 	if mv := UniMethod(v, s); mv != nil {
 		return mv
 	}
-	panic(NewExn("Unrecognized field or method: "+s, v))
+	panic(NewErrDetail(ErrNoMethod, s, v))
 }
 
 // UniMethod(v,s) finds one of the universal methods defined on all types
@@ -138,7 +138,7 @@ func UniMethod(v Value, s string) *VMethVal {
 var UniMethods = map[string]*VProcedure{
 	"type":       DefProc(Type, "type", "", "return type of value"),
 	"string":     DefProc(String, "string", "", "render value as string"),
-	"image":      DefProc(Image, "image", "", "return detailed string image"),
+	"image":      DefProc(Image, "image", "d", "return detailed string image"),
 	"copy":       DefProc(Copy, "copy", "", "copy value"),
 	"external":   DefProc(External, "external", "", "export and re-import"),
 	"instanceof": DefProc(InstanceOf, "instanceof", "t", "check type relationship"),
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -31,23 +32,28 @@ var NumberType = NewType("number", "n", rNumber, Number, nil,
 // ParseNumber -- standard string-to-number conversion for Goaldi.
 // Trims leading spaces and tabs, then allows either standard Go
 // "ParseFloat" form or any Goaldi radix form (nnb, nno, nnx, nnrxxxx).
-func ParseNumber(s string) (float64, error) {
+// The result is a VNumber, or a VBigInt for an integer too large
+// to be represented exactly as a VNumber.
+func ParseNumber(s string) (Value, error) {
 	// trim leading and trailing strings; must have something left
 	s = strings.Trim(s, " \t")
 	if len(s) == 0 {
-		return 0, mtyerr
+		return nil, mtyerr
 	}
-	// try first to interpret as a decimal number (fixed or floating)
+	// check first for a long decimal integer, which must be exact
+	if len(s) > 15 && decint.MatchString(s) {
+		return parseInt(s, 10)
+	}
+	// try next to interpret as a decimal number (fixed or floating)
 	f, err := strconv.ParseFloat(s, 64)
 	if err == nil {
-		return f, nil
+		return NewNumber(f), nil
 	}
 	// check next for old Icon nnRxxx form
 	parts := nnrxx.FindStringSubmatch(s)
 	if parts != nil {
 		radix, _ := strconv.Atoi(parts[1])
-		value, err := strconv.ParseInt(parts[2], radix, 64)
-		return float64(value), err
+		return parseInt(parts[2], radix)
 	}
 	// the only other possibility is radix suffix form:  nnnb, nnno, nnnx
 	radix := 0
@@ -59,12 +65,24 @@ func ParseNumber(s string) (float64, error) {
 	case 'x':
 		radix = 16
 	default:
-		return 0, numerr
+		return nil, numerr
+	}
+	return parseInt(s[0:len(s)-1], radix)
+}
+
+// parseInt(s, radix) converts an integer string of any length exactly
+func parseInt(s string, radix int) (Value, error) {
+	if radix < 2 || radix > 36 {
+		return nil, numerr
+	}
+	z, ok := new(big.Int).SetString(s, radix)
+	if !ok {
+		return nil, numerr
 	}
-	value, err := strconv.ParseInt(s[0:len(s)-1], radix, 64)
-	return float64(value), err
+	return NewInteger(z), nil
 }
 
+var decint = regexp.MustCompile("^[-+]?[0-9]+$")
 var nnrxx = regexp.MustCompile("^([0-9]+)[rR]([0-9a-zA-Z]+)$")
 var mtyerr = errors.New("empty string for numeric conversion")
 var numerr = errors.New("malformed number")
@@ -84,7 +102,7 @@ var (
 func FloatVal(x Value) float64 {
 	defer func() {
 		if recover() != nil {
-			panic(NewExn("Number expected", x))
+			panic(NewErr(ErrNumber, x))
 		}
 	}()
 	return x.(Numerable).ToNumber().Val()
@@ -143,7 +161,10 @@ func (v *VNumber) Copy() Value {
 
 // VNumber.Before compares two numbers for sorting
 func (a *VNumber) Before(b Value, i int) bool {
-	return *a < *(b.(*VNumber))
+	if n, ok := b.(*VNumber); ok {
+		return *a < *n
+	}
+	return numCompare(a, b) < 0
 }
 
 // VNumber.Identical -- check equality for === operator
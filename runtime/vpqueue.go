@@ -0,0 +1,185 @@
+//  vpqueue.go -- VPQueue, the Goaldi type "pqueue"
+//
+//  A priority queue is a binary heap of values, ordered either by the
+//  standard comparison of L.sort() on a field index or by a procedure.
+//  A lock makes each operation atomic, so that a queue can be shared.
+
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// VPQueue is a heap of values with its ordering
+type VPQueue struct {
+	sync.Mutex          // lock for sharing among co-expressions
+	heap       []Value  // heap-ordered values
+	crit       sortCrit // ordering: field index or procedure
+	env        *Env     // environment for calling a procedure
+}
+
+const rPQueue = 73        // declare sort ranking
+var _ ICore = &VPQueue{}  // validate implementation
+var _ IImage = &VPQueue{} // validate implementation
+
+// NewPQueue -- construct a new, empty priority queue ordered on field i
+func NewPQueue(i int) *VPQueue {
+	return &VPQueue{heap: make([]Value, 0), crit: sortCrit{i, nil}}
+}
+
+// PQueueType is the pqueue instance of type type.
+var PQueueType = NewType("pqueue", "Q", rPQueue, PQueue, PQueueMethods,
+	"pqueue", "cmp", "create a priority queue")
+
+// VPQueue.String -- default conversion to Go string returns "Q:size"
+func (Q *VPQueue) String() string {
+	Q.Lock()
+	defer Q.Unlock()
+	return fmt.Sprintf("Q:%d", len(Q.heap))
+}
+
+// VPQueue.GoString -- convert to Go string for image() and printf("%#v")
+func (Q *VPQueue) GoString() string {
+	return Q.Image(1)
+}
+
+// VPQueue.Image -- return image with values in order, to depth d-1
+func (Q *VPQueue) Image(d int) string {
+	l := Q.sorted()
+	if len(l) == 0 {
+		return "pqueue{}"
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "pqueue{")
+	for _, v := range l {
+		fmt.Fprintf(&b, "%s,", ImageDepth(v, d-1))
+	}
+	s := b.Bytes()
+	s[len(s)-1] = '}'
+	return string(s)
+}
+
+// VPQueue.Type -- return the pqueue type
+func (Q *VPQueue) Type() IRank {
+	return PQueueType
+}
+
+// VPQueue.Copy returns a duplicate of itself with the same ordering
+func (Q *VPQueue) Copy() Value {
+	Q.Lock()
+	defer Q.Unlock()
+	r := &VPQueue{heap: make([]Value, len(Q.heap)),
+		crit: Q.crit, env: Q.env}
+	copy(r.heap, Q.heap)
+	return r
+}
+
+// VPQueue.Before compares two priority queues for sorting
+func (Q *VPQueue) Before(b Value, i int) bool {
+	return false // no ordering defined
+}
+
+// VPQueue.Import returns itself
+func (Q *VPQueue) Import() Value {
+	return Q
+}
+
+// VPQueue.Export returns itself
+func (Q *VPQueue) Export() interface{} {
+	return Q
+}
+
+// VPQueue.sorted() returns the values of Q in order of removal
+func (Q *VPQueue) sorted() []Value {
+	Q.Lock()
+	defer Q.Unlock()
+	l := &pqSorter{make([]Value, len(Q.heap)), Q}
+	copy(l.v, Q.heap)
+	sort.Stable(l)
+	return l.v
+}
+
+// a list of values to be sorted in queue order
+type pqSorter struct {
+	v []Value  // values
+	q *VPQueue // queue supplying the ordering
+}
+
+// sort interface functions
+func (a *pqSorter) Len() int           { return len(a.v) }
+func (a *pqSorter) Swap(i, j int)      { a.v[i], a.v[j] = a.v[j], a.v[i] }
+func (a *pqSorter) Less(i, j int) bool { return a.q.before(a.v[i], a.v[j]) }
+
+// VPQueue.before(x, y) reports whether x is removed ahead of y
+func (Q *VPQueue) before(x Value, y Value) bool {
+	return Q.crit.less(Q.env, x, y)
+}
+
+//  The heap operations make all their comparisons before moving any
+//  values, so that if an ordering procedure throws an exception,
+//  the heap is left as it was.
+
+// VPQueue.add(x) adds x to Q
+func (Q *VPQueue) add(x Value) {
+	Q.Lock()
+	defer Q.Unlock()
+	// find the place for x at the end of the heap or above it
+	path := make([]int, 0)
+	for i := len(Q.heap); i > 0; {
+		p := (i - 1) / 2
+		if !Q.before(x, Q.heap[p]) {
+			break
+		}
+		path = append(path, p)
+		i = p
+	}
+	// move the values on the path down, and put x in the hole
+	i := len(Q.heap)
+	Q.heap = append(Q.heap, nil)
+	for _, p := range path {
+		Q.heap[i] = Q.heap[p]
+		i = p
+	}
+	Q.heap[i] = x
+}
+
+// VPQueue.first(remove) returns the first value of Q, removing it
+// if requested, or returns nil if Q is empty
+func (Q *VPQueue) first(remove bool) Value {
+	Q.Lock()
+	defer Q.Unlock()
+	if len(Q.heap) == 0 {
+		return nil
+	} else if !remove {
+		return Q.heap[0]
+	}
+	// find the place for the last value, starting from the top
+	n := len(Q.heap) - 1
+	y := Q.heap[n]
+	path := make([]int, 0)
+	for i := 0; 2*i+1 < n; {
+		c := 2*i + 1
+		if c+1 < n && Q.before(Q.heap[c+1], Q.heap[c]) {
+			c++
+		}
+		if !Q.before(Q.heap[c], y) {
+			break
+		}
+		path = append(path, c)
+		i = c
+	}
+	// move the values on the path up, and put the last value in the hole
+	x := Q.heap[0]
+	i := 0
+	for _, c := range path {
+		Q.heap[i] = Q.heap[c]
+		i = c
+	}
+	Q.heap[i] = y
+	Q.heap[n] = nil
+	Q.heap = Q.heap[:n]
+	return x
+}
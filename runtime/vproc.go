@@ -161,7 +161,7 @@ func GoShim(name string, f interface{} /*func*/, retf int) Procedure {
 	ftype := reflect.TypeOf(f)
 	fval := reflect.ValueOf(f)
 	if fval.Kind() != reflect.Func {
-		panic(NewExn("Not a func", f))
+		panic(NewErr(ErrNotFunc, f))
 	}
 	nargs := ftype.NumIn()
 	nfixed := nargs
@@ -202,7 +202,7 @@ func GoShim(name string, f interface{} /*func*/, retf int) Procedure {
 			}
 			v = passer[i](a)
 			if !v.IsValid() {
-				panic(NewExn("Cannot convert argument", args[i]))
+				panic(NewErr(ErrArgConv, args[i]))
 			}
 			in = append(in, v)
 		}
@@ -211,7 +211,7 @@ func GoShim(name string, f interface{} /*func*/, retf int) Procedure {
 			for i := nfixed; i < len(args); i++ {
 				v = passer[nfixed](args[i])
 				if !v.IsValid() {
-					panic(NewExn("Cannot convert argument", args[i]))
+					panic(NewErr(ErrArgConv, args[i]))
 				}
 				in = append(in, v)
 			}
@@ -253,6 +253,28 @@ func GoShim(name string, f interface{} /*func*/, retf int) Procedure {
 	}
 }
 
+// intArg(v, t) converts v to a number, truncates it to an integer,
+// and returns it as a value of integer type t.
+// It panics if the integer does not fit in type t.
+func intArg(v Value, t reflect.Type) reflect.Value {
+	z := ival(v)
+	r := reflect.New(t).Elem()
+	bits := uint(t.Bits())
+	if t.Kind() >= reflect.Uint {
+		if !z.IsUint64() || bits < 64 && z.Uint64() >= 1<<bits {
+			panic(NewErr(ErrArgConv, v))
+		}
+		r.SetUint(z.Uint64())
+	} else {
+		lim := int64(1) << (bits - 1)
+		if !z.IsInt64() || bits < 64 && (z.Int64() >= lim || z.Int64() < -lim) {
+			panic(NewErr(ErrArgConv, v))
+		}
+		r.SetInt(z.Int64())
+	}
+	return r
+}
+
 // passfunc returns a function that converts a Goaldi value
 // into a Go reflect.Value of the specified type
 func passfunc(t reflect.Type) func(Value) reflect.Value {
@@ -282,6 +304,8 @@ func passfunc(t reflect.Type) func(Value) reflect.Value {
 			return func(v Value) reflect.Value {
 				if reflect.TypeOf(v).ConvertibleTo(t) {
 					return reflect.ValueOf(v).Convert(t)
+				} else if k >= reflect.Int && k <= reflect.Uintptr {
+					return intArg(v, t)
 				} else {
 					return reflect.ValueOf(FloatVal(v)).Convert(t)
 				}
@@ -292,6 +316,8 @@ func passfunc(t reflect.Type) func(Value) reflect.Value {
 			return func(v Value) reflect.Value {
 				if reflect.TypeOf(v).ConvertibleTo(t) {
 					return reflect.ValueOf(v).Convert(t)
+				} else if b, ok := v.(*VBytes); ok {
+					return reflect.ValueOf(b.Export()).Convert(t)
 				} else {
 					return reflect.ValueOf(ToString(v).ToUTF8()).Convert(t)
 				}
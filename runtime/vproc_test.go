@@ -0,0 +1,53 @@
+//  vproc_test.go -- test passing Goaldi values to Go functions
+
+package runtime
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// passArg calls Go function f with argument v and returns the result,
+// or the code of the exception thrown
+func passArg(f interface{}, v Value) (r Value) {
+	defer func() {
+		if p := recover(); p != nil {
+			for cf, ok := p.(*CallFrame); ok; cf, ok = p.(*CallFrame) {
+				p = cf.cause
+			}
+			r = p.(*Exception).Code
+		}
+	}()
+	r, _ = GoShim("f", f, RNORM)(nil, v)
+	return r
+}
+
+// testPass checks that passing v to f gives the expected result
+func testPass(t *testing.T, f interface{}, v Value, expected Value) {
+	if r := passArg(f, v); fmt.Sprint(r) != fmt.Sprint(expected) {
+		t.Errorf("passing %v: expected %#v, got %#v", v, expected, r)
+	}
+}
+
+func TestPassInt(t *testing.T) {
+	i8 := func(i int8) int8 { return i }
+	u32 := func(i uint32) uint32 { return i }
+	i64 := func(i int64) int64 { return i }
+	u64 := func(i uint64) uint64 { return i }
+	big64 := NewInteger(new(big.Int).Lsh(big.NewInt(1), 63))
+	big70 := NewInteger(new(big.Int).Lsh(big.NewInt(1), 70))
+
+	testPass(t, i8, NewNumber(127), NewNumber(127))
+	testPass(t, i8, NewNumber(-128), NewNumber(-128))
+	testPass(t, i8, NewNumber(128), ErrArgConv)
+	testPass(t, i8, NewNumber(-129), ErrArgConv)
+	testPass(t, i8, NewNumber(300), ErrArgConv)
+	testPass(t, u32, NewNumber(4294967295), NewNumber(4294967295))
+	testPass(t, u32, NewNumber(4294967296), ErrArgConv)
+	testPass(t, u32, NewNumber(-1), ErrArgConv)
+	testPass(t, i64, big64, ErrArgConv)
+	testPass(t, i64, big70, ErrArgConv)
+	testPass(t, u64, big64, big64)
+	testPass(t, u64, big70, ErrArgConv)
+}
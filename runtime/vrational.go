@@ -0,0 +1,150 @@
+//  vrational.go -- VRational, the Goaldi type "rational"
+//
+//  A rational number is an exact quotient of two integers of any size.
+//  Rationals combine with integers and decimals to give exact rational
+//  results; see onumber.go for the rules of mixed arithmetic.
+
+package runtime
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type VRational big.Rat
+
+// NewRational -- construct a Goaldi rational from a big.Rat,
+// which must not be changed later
+func NewRational(r *big.Rat) *VRational {
+	return (*VRational)(r)
+}
+
+const rRational = rNumber                    // sorts along with numbers
+var _ ICore = NewRational(new(big.Rat))      // validate implementation
+var _ Numerable = NewRational(new(big.Rat))  // validate implementation
+var _ Stringable = NewRational(new(big.Rat)) // validate implementation
+
+// RationalType is the rational instance of type type.
+var RationalType = NewType("rational", "q", rRational, Rational, nil,
+	"rational", "x,d", "convert to rational")
+
+// rational(x, d) returns the exact quotient x / d as a rational value.
+// The default value of d is 1.
+// x and d may be integers, rationals, decimals, or strings
+// of a form such as "22/7", "-1.25", or "6.02e23".
+// A non-integer number is converted from its shortest decimal form,
+// so that rational(0.1) is 1/10.
+// rational() fails if x or d cannot be converted.
+func Rational(env *Env, args ...Value) (Value, *Closure) {
+	// nonstandard entry; on panic, returns default nil values to fail
+	defer func() { recover() }()
+	x := toRat(ProcArg(args, 0, NilValue))
+	d := toRat(ProcArg(args, 1, ONE))
+	if d.Sign() == 0 {
+		return Fail()
+	}
+	return Return(NewRational(new(big.Rat).Quo(x, d)))
+}
+
+// toRat(x) converts any convertible value to a big.Rat, or panics
+func toRat(x Value) *big.Rat {
+	if s, ok := x.(*VString); ok {
+		t := strings.Trim(s.ToUTF8(), " \t")
+		if r, ok := new(big.Rat).SetString(t); ok {
+			return r
+		}
+	}
+	return ratval(numval(x))
+}
+
+// ratval(n) returns the exact value of number n as a big.Rat,
+// which must not be changed.
+// An ordinary float is converted from its shortest decimal form.
+func ratval(n Value) *big.Rat {
+	switch v := n.(type) {
+	case *VRational:
+		return v.Rat()
+	case *VDecimal:
+		return v.Rat()
+	case *VBigInt:
+		return new(big.Rat).SetInt(v.Int())
+	default:
+		f := fval(n)
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			panic(NewErr(ErrInfinite, n))
+		}
+		r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+		return r
+	}
+}
+
+// VRational.Rat -- return underlying big.Rat, which must not be changed
+func (v *VRational) Rat() *big.Rat {
+	return (*big.Rat)(v)
+}
+
+// VRational.String -- default conversion to Go string, e.g. "22/7"
+func (v *VRational) String() string {
+	return v.Rat().RatString()
+}
+
+// VRational.GoString -- convert to Go string for image() and printf("%#v")
+func (v *VRational) GoString() string {
+	return "rational(" + v.Rat().RatString() + ")"
+}
+
+// VRational.ToString -- convert to Goaldi string
+func (v *VRational) ToString() *VString {
+	return NewString(v.String())
+}
+
+// VRational.ToNumber -- convert to nearest VNumber
+func (v *VRational) ToNumber() *VNumber {
+	f, _ := v.Rat().Float64()
+	return NewNumber(f)
+}
+
+// VRational.Type -- return the rational type
+func (v *VRational) Type() IRank {
+	return RationalType
+}
+
+// VRational.Copy returns itself
+func (v *VRational) Copy() Value {
+	return v
+}
+
+// VRational.Before compares two numbers for sorting
+func (a *VRational) Before(b Value, i int) bool {
+	return numCompare(a, b) < 0
+}
+
+// VRational.Identical -- check equality for === operator
+func (a *VRational) Identical(x Value) Value {
+	if b, ok := x.(*VRational); ok && a.Rat().Cmp(b.Rat()) == 0 {
+		return x
+	} else {
+		return nil
+	}
+}
+
+// VRational.Import returns itself
+func (v *VRational) Import() Value {
+	return v
+}
+
+// VRational.Export returns a copy of the value as a *big.Rat
+func (v *VRational) Export() interface{} {
+	return new(big.Rat).Set(v.Rat())
+}
+
+// ratKey is the Go map key for a VRational (see GoKey)
+type ratKey string
+
+// ratKey.Import converts a map key back into a VRational
+func (k ratKey) Import() Value {
+	r, _ := new(big.Rat).SetString(string(k))
+	return NewRational(r)
+}
@@ -22,13 +22,18 @@ func (v *VRecord) String() string {
 
 // VRecord.GoString -- returns string for image() and printf("%#v")
 func (v *VRecord) GoString() string {
+	return v.Image(1)
+}
+
+// VRecord.Image -- returns image with field values shown to depth d-1
+func (v *VRecord) Image(d int) string {
 	if len(v.Data) == 0 {
 		return v.Ctor.TypeName + "{}"
 	}
 	var b bytes.Buffer
 	fmt.Fprintf(&b, "%s{", v.Ctor.TypeName)
 	for i, x := range v.Data {
-		fmt.Fprintf(&b, "%v:%v,", v.Ctor.Flist[i], x)
+		fmt.Fprintf(&b, "%v:%s,", v.Ctor.Flist[i], ImageDepth(x, d-1))
 	}
 	s := b.Bytes()
 	s[len(s)-1] = '}'
@@ -49,7 +54,11 @@ func (v *VRecord) Copy() Value {
 
 // VRecord.Before compares two records for sorting on field i
 func (a *VRecord) Before(x Value, i int) bool {
-	b := x.(*VRecord)
+	b, ok := x.(*VRecord)
+	if !ok {
+		// not a record, but an exception of the same rank; order by type name
+		return a.Ctor.TypeName < x.(ICore).Type().(*VCtor).TypeName
+	}
 	if a.Ctor != b.Ctor {
 		// different record types; order by type name
 		return a.Ctor.TypeName < b.Ctor.TypeName
@@ -0,0 +1,90 @@
+//  vscanner.go -- VScanner, the Goaldi type "scanner"
+//
+//  A scanner holds a subject string and a current position within it,
+//  providing the string scanning facilities of Icon as methods.
+//  Positions are numbered from 1, as in Icon, and may also be given
+//  as nonpositive values counting back from the end of the subject.
+
+package runtime
+
+import (
+	"fmt"
+)
+
+// A scanner is a subject string and a position.
+type VScanner struct {
+	subject *VString // string being scanned
+	runes   []rune   // characters of subject
+	pos     int      // current position (zero-based Go index)
+}
+
+// NewScanner(s) -- construct a new scanner positioned at the start of s
+func NewScanner(s *VString) *VScanner {
+	return &VScanner{s, s.ToRunes(), 0}
+}
+
+const rScanner = 75        // declare sort ranking
+var _ ICore = &VScanner{}  // validate implementation
+var _ IImage = &VScanner{} // validate implementation
+
+// ScannerType is the scanner instance of type type.
+var ScannerType = NewType("scanner", "Y", rScanner, Scanner, ScannerMethods,
+	"scanner", "s,i", "create string scanner")
+
+// scanner(s, i) creates a scanner for the subject string s,
+// positioned initially at position i, which defaults to 1.
+func Scanner(env *Env, args ...Value) (Value, *Closure) {
+	defer Traceback("scanner", args)
+	y := NewScanner(ToString(ProcArg(args, 0, EMPTY)))
+	i := GoIndex(IntVal(ProcArg(args, 1, ONE)), len(y.runes))
+	if i > len(y.runes) {
+		panic(NewErr(ErrIndex, ProcArg(args, 1, ONE)))
+	}
+	y.pos = i
+	return Return(y)
+}
+
+// VScanner.String -- default conversion to Go string returns "Y:pos"
+func (y *VScanner) String() string {
+	return fmt.Sprintf("Y:%d", y.pos+1)
+}
+
+// VScanner.GoString -- convert to Go string for image() and printf("%#v")
+func (y *VScanner) GoString() string {
+	return y.Image(1)
+}
+
+// VScanner.Image -- return image showing subject and position
+func (y *VScanner) Image(d int) string {
+	return fmt.Sprintf("scanner(%s,%d)", ImageDepth(y.subject, d), y.pos+1)
+}
+
+// VScanner.Type -- return the scanner type
+func (y *VScanner) Type() IRank {
+	return ScannerType
+}
+
+// VScanner.Copy returns a new scanner with the same subject and position
+func (y *VScanner) Copy() Value {
+	return &VScanner{y.subject, y.runes, y.pos}
+}
+
+// VScanner.Before compares two scanners for sorting
+// by subject and then by position
+func (a *VScanner) Before(x Value, i int) bool {
+	b := x.(*VScanner)
+	if c := a.subject.compare(b.subject); c != 0 {
+		return c < 0
+	}
+	return a.pos < b.pos
+}
+
+// VScanner.Import returns itself
+func (y *VScanner) Import() Value {
+	return y
+}
+
+// VScanner.Export returns itself
+func (y *VScanner) Export() interface{} {
+	return y
+}
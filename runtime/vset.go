@@ -1,9 +1,10 @@
 //	vset.go -- VSet, the Goaldi type "set"
 //
 //	Implementation:
-//	A Goaldi set is just a type name VSet attached to a Go map[Value]bool.
+//	A Goaldi set is a type name VSet attached to a Go map[Value]bool.
 //	This distinguishes it from an external Go map and allows attaching methods.
 //	Goaldi strings and numbers are converted to Go string and float64 values.
+//	A set keyed by value also indexes its structured members (see keyIndex).
 //  Much of this is very similar to the implementation of the table type.
 
 package runtime
@@ -17,18 +18,54 @@ import (
 // Strings and numbers are converted before use as keys;
 // otherwise, unconverted "identical" values would appear distinct.
 // All map values are "true"; deletions remove non-member keys.
-type VSet map[Value]bool
+type VSet struct {
+	members map[Value]bool // underlying Go map
+	index   keyIndex       // structured members, if keyed by value
+}
 
 const rSet = 65       // declare sort ranking
 var _ ICore = &VSet{} // validate implementation
 
 // NewSet -- construct a new Goaldi set from a Goaldi list.
 func NewSet(L *VList) *VSet {
-	S := VSet(make(map[Value]bool))
+	S := &VSet{make(map[Value]bool), nil}
 	for _, v := range L.data {
-		S[GoKey(v)] = true
+		S.members[GoKey(v)] = true
+	}
+	return S
+}
+
+// NewValueSet -- construct a new set, keyed by value, from a Goaldi list.
+func NewValueSet(L *VList) *VSet {
+	S := &VSet{make(map[Value]bool), make(keyIndex)}
+	for _, v := range L.data {
+		S.add(v)
+	}
+	return S
+}
+
+// VSet.key(x) returns the map key for x, which may or may not be a member
+func (S *VSet) key(x Value) interface{} {
+	return S.index.lookup(x)
+}
+
+// VSet.has(x) reports whether x is a member of S
+func (S *VSet) has(x Value) bool {
+	return S.members[S.key(x)]
+}
+
+// VSet.add(x) adds x to S
+func (S *VSet) add(x Value) {
+	S.members[S.index.insert(x)] = true
+}
+
+// VSet.remove(x) removes x from S
+func (S *VSet) remove(x Value) {
+	k := S.key(x)
+	if S.members[k] {
+		delete(S.members, k)
+		S.index.remove(k)
 	}
-	return &S
 }
 
 // GoKey(v) turns a Goaldi value into something usable as a Go map key.
@@ -41,41 +78,151 @@ func GoKey(v Value) interface{} {
 		return t.ToUTF8()
 	case *VNumber:
 		return t.Val()
+	case *VBigInt:
+		return bigKey(t.String())
+	case *VRational:
+		return ratKey(t.String())
+	case *VDecimal:
+		return decKey(t.String())
+	case *VComplex:
+		return t.Val()
+	case *VCset:
+		return csetKey(t.String())
+	case *VBytes:
+		return bytesKey(t.b)
 	default:
 		return v
 	}
 }
 
+// A keyIndex lists, by StructHash value, the structured keys of a table
+// or set that is keyed by value, so that an equal list or record finds
+// the same entry.  Each list, set, table, or record key is represented in
+// the underlying map by a deep copy of the first equal value added,
+// so that later changes to the value added do not strand the entry;
+// other values are converted by GoKey.
+// The nil keyIndex converts all keys by GoKey.
+type keyIndex map[uint64][]Value
+
+// isStructure(x) reports whether x is a list, set, table, sorted map,
+// or record
+func isStructure(x Value) bool {
+	switch x.(type) {
+	case *VList, *VSet, *VTable, *VSortMap, *VRecord:
+		return true
+	default:
+		return false
+	}
+}
+
+// keyIndex.lookup(x) returns the map key for x without adding it
+func (ix keyIndex) lookup(x Value) interface{} {
+	if ix == nil || !isStructure(x) {
+		return GoKey(x)
+	}
+	for _, k := range ix[StructHash(x)] {
+		if StructEqual(k, x) {
+			return k
+		}
+	}
+	return x
+}
+
+// keyIndex.insert(x) returns the map key for x, adding it if new
+func (ix keyIndex) insert(x Value) interface{} {
+	if ix == nil || !isStructure(x) {
+		return GoKey(x)
+	}
+	h := StructHash(x)
+	for _, k := range ix[h] {
+		if StructEqual(k, x) {
+			return k
+		}
+	}
+	x = deepCopy(x, make(map[Value]Value))
+	ix[h] = append(ix[h], x)
+	return x
+}
+
+// keyIndex.remove(k) removes map key k from the index
+func (ix keyIndex) remove(k interface{}) {
+	if ix == nil || !isStructure(k) {
+		return
+	}
+	h := StructHash(k)
+	if !ix.drop(h, k) {
+		// not found: the key must have been modified since it was added
+		for h := range ix {
+			if ix.drop(h, k) {
+				break
+			}
+		}
+	}
+}
+
+// keyIndex.drop(h, k) removes k from the list for hash h, if present
+func (ix keyIndex) drop(h uint64, k interface{}) bool {
+	l := ix[h]
+	for i := range l {
+		if l[i] == k {
+			if len(l) == 1 {
+				delete(ix, h)
+			} else {
+				ix[h] = append(l[:i:i], l[i+1:]...)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// keyIndex.copy() returns a copy of the index
+func (ix keyIndex) copy() keyIndex {
+	if ix == nil {
+		return nil
+	}
+	c := make(keyIndex, len(ix))
+	for h, l := range ix {
+		c[h] = append([]Value(nil), l...)
+	}
+	return c
+}
+
 // SetType is the set instance of type type.
 var SetType = NewType("set", "S", rSet, Set, SetMethods,
-	"set", "L", "create a new set from list L")
+	"set", "L,byvalue", "create a new set from list L")
 
 // SetVal(x) return x as a Set, or throws an exception.
 func SetVal(x Value) *VSet {
 	if S, ok := x.(*VSet); ok {
 		return S
 	} else {
-		panic(NewExn("Not a set", x))
+		panic(NewErr(ErrSet, x))
 	}
 }
 
 // VSet.String -- default conversion to Go string returns "S:size"
 func (S *VSet) String() string {
-	return fmt.Sprintf("S:%d", len(*S))
+	return fmt.Sprintf("S:%d", len(S.members))
 }
 
 // VSet.GoString -- convert to Go string for image() and printf("%#v")
 //
 // For utility and reproducibility, we accept the cost of sorting the set.
 func (S *VSet) GoString() string {
-	if len(*S) == 0 {
+	return S.Image(1)
+}
+
+// VSet.Image -- returns image with members shown to depth d-1
+func (S *VSet) Image(d int) string {
+	if len(S.members) == 0 {
 		return "set{}"
 	}
 	l, _ := S.Sort(ONE) // sort on key values
 	var b bytes.Buffer
 	fmt.Fprintf(&b, "set{")
 	for _, e := range l.(*VList).data {
-		fmt.Fprintf(&b, "%v,", e)
+		fmt.Fprintf(&b, "%s,", ImageDepth(e, d-1))
 	}
 	s := b.Bytes()
 	s[len(s)-1] = '}'
@@ -89,13 +236,35 @@ func (S *VSet) Type() IRank {
 
 // VSet.Copy returns a duplicate of itself
 func (S *VSet) Copy() Value {
-	r := NewSet(EMPTYLIST)
-	for k := range *S {
-		(*r)[k] = true
+	r := S.empty()
+	for k := range S.members {
+		r.members[k] = true
 	}
+	r.index = S.index.copy()
 	return r
 }
 
+// VSet.empty returns a new, empty set keyed in the same way as S
+func (S *VSet) empty() *VSet {
+	if S.index != nil {
+		return NewValueSet(EMPTYLIST)
+	}
+	return NewSet(EMPTYLIST)
+}
+
+// VSet.like(S2) returns S2 if it has the same key policy as S,
+// or otherwise a copy of S2 whose members are compared as in S
+func (S *VSet) like(S2 *VSet) *VSet {
+	if (S.index == nil) == (S2.index == nil) {
+		return S2
+	}
+	c := S.empty()
+	for k := range S2.members {
+		c.add(Import(k))
+	}
+	return c
+}
+
 // VSet.Before compares two sets for sorting
 func (a *VSet) Before(b Value, i int) bool {
 	return false // no ordering defined
@@ -109,5 +278,5 @@ func (S *VSet) Import() Value {
 // VSet.Export returns its underlying map[Value]bool.
 // Go extensions may wish to use GoKey() for proper conversion of keys.
 func (S *VSet) Export() interface{} {
-	return map[Value]bool(*S)
+	return S.members
 }
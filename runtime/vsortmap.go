@@ -0,0 +1,389 @@
+//  vsortmap.go -- VSortMap, the Goaldi type "sortmap"
+//
+//  A sorted map associates keys with values, like a table, but keeps
+//  its keys in order using the standard comparison of L.sort().
+//  Keys that compare neither less nor greater are the same key, except
+//  that distinct structures are always distinct keys:  among structures
+//  that compare equally, the map keeps them in the order they were added,
+//  and a structure that is not a key follows all of them.
+//  A NaN key is rejected, because it compares with nothing.
+//  The map is an AVL tree whose nodes also record subtree sizes.
+
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+)
+
+// VSortMap is a default value and a balanced tree of entries
+type VSortMap struct {
+	root   *smNode       // root of tree, or nil if empty
+	dfval  Value         // default value
+	serial map[Value]int // arrival numbers of structured keys
+	next   int           // next arrival number
+}
+
+// smNode is a node of the tree holding one entry
+type smNode struct {
+	key, val    Value   // entry
+	serial      int     // arrival number, if key is a structure
+	left, right *smNode // subtrees of smaller and larger keys
+	height      int     // height of subtree rooted here
+	size        int     // number of entries in subtree rooted here
+}
+
+const rSortMap = 72        // declare sort ranking
+var _ ICore = &VSortMap{}  // validate implementation
+var _ IImage = &VSortMap{} // validate implementation
+
+// NewSortMap -- construct a new, empty sorted map
+func NewSortMap(dfval Value) *VSortMap {
+	return &VSortMap{nil, dfval, nil, 0}
+}
+
+// SortMapType is the sortmap instance of type type.
+var SortMapType = NewType("sortmap", "M", rSortMap, SortMap, SortMapMethods,
+	"sortmap", "x", "create a sorted map with default value x")
+
+// VSortMap.String -- default conversion to Go string returns "M:size"
+func (M *VSortMap) String() string {
+	return fmt.Sprintf("M:%d", M.root.count())
+}
+
+// VSortMap.GoString -- convert to Go string for image() and printf("%#v")
+func (M *VSortMap) GoString() string {
+	return M.Image(1)
+}
+
+// VSortMap.Image -- returns image with keys and values shown to depth d-1
+func (M *VSortMap) Image(d int) string {
+	if M.root == nil {
+		return "sortmap{}"
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "sortmap{")
+	M.root.walk(func(n *smNode) {
+		fmt.Fprintf(&b, "%s:%s,", ImageDepth(n.key, d-1), ImageDepth(n.val, d-1))
+	})
+	s := b.Bytes()
+	s[len(s)-1] = '}'
+	return string(s)
+}
+
+// VSortMap.Type -- return the sortmap type
+func (M *VSortMap) Type() IRank {
+	return SortMapType
+}
+
+// VSortMap.Copy returns a duplicate of itself
+func (M *VSortMap) Copy() Value {
+	serial := make(map[Value]int, len(M.serial))
+	for k, s := range M.serial {
+		serial[k] = s
+	}
+	return &VSortMap{M.root.copy(), M.dfval, serial, M.next}
+}
+
+// VSortMap.Before compares two sorted maps for sorting
+func (M *VSortMap) Before(b Value, i int) bool {
+	return false // no ordering defined
+}
+
+// VSortMap.Import returns itself
+func (M *VSortMap) Import() Value {
+	return M
+}
+
+// VSortMap.Export returns itself
+func (M *VSortMap) Export() interface{} {
+	return M
+}
+
+//  -------------------------- tree operations ---------------------
+
+// smLast is the arrival number of a structure that is not a key
+const smLast = int(^uint(0) >> 1)
+
+// smCompare(x, xs, y, ys) returns -1, 0, or +1 as key x sorts before,
+// with, or after y, using arrival numbers xs and ys to break ties
+func smCompare(x Value, xs int, y Value, ys int) int {
+	switch {
+	case LT(x, y, 0):
+		return -1
+	case LT(y, x, 0):
+		return +1
+	case xs < ys:
+		return -1
+	case xs > ys:
+		return +1
+	default:
+		return 0
+	}
+}
+
+// VSortMap.arrival(k) returns the arrival number of k:
+// zero if k is not a structure, or smLast if it is not a key of M
+func (M *VSortMap) arrival(k Value) int {
+	if !isStructure(k) {
+		return 0
+	}
+	if s, ok := M.serial[k]; ok {
+		return s
+	}
+	return smLast
+}
+
+// smKey(k) panics if k is not a valid key
+func smKey(k Value) {
+	if n, ok := k.(*VNumber); ok && math.IsNaN(n.Val()) {
+		panic(NewErr(ErrInfinite, k))
+	}
+}
+
+// VSortMap.find(k) returns the node with key k, or nil
+func (M *VSortMap) find(k Value) *smNode {
+	smKey(k)
+	s := M.arrival(k)
+	n := M.root
+	for n != nil {
+		switch smCompare(k, s, n.key, n.serial) {
+		case -1:
+			n = n.left
+		case +1:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// VSortMap.seek(k, s, up, strict) returns the node with the least key
+// at or above k (if up) or the greatest key at or below k (if !up),
+// excluding k itself if strict, where s is the arrival number of k.
+// It returns nil if there is none.
+func (M *VSortMap) seek(k Value, s int, up bool, strict bool) *smNode {
+	smKey(k)
+	var best *smNode
+	n := M.root
+	for n != nil {
+		c := smCompare(n.key, n.serial, k, s)
+		if c == 0 && !strict {
+			return n
+		}
+		if up && c > 0 || !up && c < 0 {
+			best = n // candidate; look for a closer one
+			if up {
+				n = n.left
+			} else {
+				n = n.right
+			}
+		} else if up {
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return best
+}
+
+// VSortMap.end(up) returns the node with the greatest key (if up)
+// or the least key (if !up), or nil if the map is empty
+func (M *VSortMap) end(up bool) *smNode {
+	n := M.root
+	for n != nil {
+		next := n.left
+		if up {
+			next = n.right
+		}
+		if next == nil {
+			return n
+		}
+		n = next
+	}
+	return nil
+}
+
+// VSortMap.entries() returns the nodes of M in key order
+func (M *VSortMap) entries() []*smNode {
+	l := make([]*smNode, 0, M.root.count())
+	M.root.walk(func(n *smNode) {
+		l = append(l, n)
+	})
+	return l
+}
+
+// VSortMap.put(k, v) adds or replaces the entry for key k
+func (M *VSortMap) put(k Value, v Value) {
+	smKey(k)
+	s := M.arrival(k)
+	if s == smLast { // a new structured key
+		if M.serial == nil {
+			M.serial = make(map[Value]int)
+		}
+		s = M.next
+		M.next++
+		M.serial[k] = s
+	}
+	M.root = M.root.insert(k, s, v)
+}
+
+// VSortMap.remove(k) removes the entry for key k, if any
+func (M *VSortMap) remove(k Value) {
+	smKey(k)
+	M.root = M.root.delete(k, M.arrival(k))
+	delete(M.serial, k)
+}
+
+// smNode.count() returns the number of entries in the subtree
+func (n *smNode) count() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// smNode.depth() returns the height of the subtree
+func (n *smNode) depth() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// smNode.nth(i) returns the node of zero-based rank i in the subtree
+func (n *smNode) nth(i int) *smNode {
+	for {
+		l := n.left.count()
+		if i < l {
+			n = n.left
+		} else if i > l {
+			i -= l + 1
+			n = n.right
+		} else {
+			return n
+		}
+	}
+}
+
+// smNode.walk(f) calls f for each node of the subtree in key order
+func (n *smNode) walk(f func(*smNode)) {
+	if n != nil {
+		n.left.walk(f)
+		f(n)
+		n.right.walk(f)
+	}
+}
+
+// smNode.copy() returns a duplicate of the subtree
+func (n *smNode) copy() *smNode {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	c.left = n.left.copy()
+	c.right = n.right.copy()
+	return &c
+}
+
+// smNode.insert(k, s, v) adds or replaces the entry for k, which has
+// arrival number s, and returns the new root of the subtree
+func (n *smNode) insert(k Value, s int, v Value) *smNode {
+	if n == nil {
+		return &smNode{k, v, s, nil, nil, 1, 1}
+	}
+	switch smCompare(k, s, n.key, n.serial) {
+	case -1:
+		n.left = n.left.insert(k, s, v)
+	case +1:
+		n.right = n.right.insert(k, s, v)
+	default:
+		n.val = v
+		return n
+	}
+	return n.balance()
+}
+
+// smNode.delete(k, s) removes the entry for k, which has arrival number s,
+// if any, and returns the new root of the subtree
+func (n *smNode) delete(k Value, s int) *smNode {
+	if n == nil {
+		return nil
+	}
+	switch smCompare(k, s, n.key, n.serial) {
+	case -1:
+		n.left = n.left.delete(k, s)
+	case +1:
+		n.right = n.right.delete(k, s)
+	default:
+		if n.left == nil {
+			return n.right
+		} else if n.right == nil {
+			return n.left
+		}
+		var m *smNode
+		n.right, m = n.right.deleteMin()
+		m.left, m.right = n.left, n.right
+		n = m
+	}
+	return n.balance()
+}
+
+// smNode.deleteMin() removes the least node of a nonempty subtree,
+// returning the new root and the removed node
+func (n *smNode) deleteMin() (*smNode, *smNode) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var m *smNode
+	n.left, m = n.left.deleteMin()
+	return n.balance(), m
+}
+
+// smNode.fix() recomputes the height and size of n from its subtrees
+func (n *smNode) fix() *smNode {
+	l, r := n.left.depth(), n.right.depth()
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+	n.size = n.left.count() + n.right.count() + 1
+	return n
+}
+
+// smNode.balance() restores the AVL property at n after a change
+// to one of its subtrees, returning the new root of the subtree
+func (n *smNode) balance() *smNode {
+	switch n.left.depth() - n.right.depth() {
+	case 2:
+		if n.left.left.depth() < n.left.right.depth() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case -2:
+		if n.right.right.depth() < n.right.left.depth() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n.fix()
+}
+
+// smNode.rotateLeft() raises the right child of n
+func (n *smNode) rotateLeft() *smNode {
+	r := n.right
+	n.right = r.left
+	r.left = n.fix()
+	return r.fix()
+}
+
+// smNode.rotateRight() raises the left child of n
+func (n *smNode) rotateRight() *smNode {
+	l := n.left
+	n.left = l.right
+	l.right = n.fix()
+	return l.fix()
+}
@@ -2,13 +2,32 @@
 //
 //	Strings contain sequences of Unicode characters (Code Points or Runes)
 //
-//	Implementation:  A []byte slice with an additional optional []int16 slice
-//	(which is used if any character is wider than 8 bits).
-//	This allows linear-time access but requires conversion to/from Go strings.
+//	Implementation:  A string has a fixed length and a representation that
+//	may take any of three forms, which are built lazily as needed:
 //
-//	Viable alternative not chosen: a rune array, fast and easy but space-costly.
-//	Difficult alternative not chosen: Go strings with annotations for quicker
-//	*s, s[i], etc. operations.
+//	  flat:  A []byte slice with an additional optional []uint16 slice
+//	  (which is used if any character is wider than 8 bits).
+//	  This allows constant-time indexing and shares storage among slices.
+//
+//	  utf8:  A Go UTF-8 string.  Strings from Go start out this way,
+//	  and the encoding is cached once computed, so that passing a string
+//	  to Go library functions or writing it does not copy it again.
+//	  Slices of an ASCII string share the Go string.
+//
+//	  rope:  A pending concatenation of two shorter strings.
+//	  Long strings are concatenated by building a rope in constant time,
+//	  so that a loop appending with ||:= is linear, not quadratic.
+//	  The pieces are copied only when a flat or utf8 form is needed.
+//
+//	The representation is replaced, never modified, when a new form is
+//	computed; this is done atomically because strings are shared freely
+//	among goroutines.  All forms denote the same immutable value.
+//
+//	Strings of one character below U+0100 are preallocated and shared,
+//	so that generating or subscripting characters allocates nothing.
+//
+//	Alternatives not chosen: a rune array, fast and easy but space-costly;
+//	Go strings alone, which make *s and s[i] linear-time operations.
 //
 //  Not known at the time:  package golang.org/x/exp/utf8string.
 
@@ -17,7 +36,10 @@ package runtime
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"unicode/utf8"
+	"unsafe"
 )
 
 // predefined constants
@@ -28,16 +50,62 @@ var (
 	UCASE = NewString("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
 )
 
-// A string is encoded by one (usually) or two parallel slices.
+// chars holds the preallocated strings of one character below U+0100
+var chars = func() (a [256]*VString) {
+	for c := range a {
+		a[c] = newString(1, &srep{flat: true, low: []uint8{uint8(c)},
+			utf8: true, str: string(rune(c))})
+	}
+	return a
+}()
+
+// ROPEMIN is the minimum length for which concatenation builds a rope.
+// Shorter results are copied immediately.
+const ROPEMIN = 64
+
+// A string is a length and a current representation.
 // The representation may change; access only via Capitalized functions below.
 type VString struct {
-	low  []uint8  // required: low-order 8 bits of each rune
-	high []uint16 // optional: high-order 13 bits of each rune
+	n   int            // length in characters
+	rep unsafe.Pointer // current *srep
+}
+
+// An srep is one representation of a string, holding one or more forms.
+type srep struct {
+	flat  bool     // true if low and high are valid
+	low   []uint8  // low-order 8 bits of each rune
+	high  []uint16 // optional: high-order 13 bits of each rune
+	utf8  bool     // true if str is valid
+	str   string   // UTF-8 encoding
+	left  *VString // rope: first part, if neither flat nor utf8
+	right *VString // rope: second part
+}
+
+// newString -- construct a VString of length n from a representation
+func newString(n int, r *srep) *VString {
+	return &VString{n, unsafe.Pointer(r)} // not yet shared; no need to be atomic
+}
+
+// strSlot allocates a string together with its first representation
+type strSlot struct {
+	v VString
+	r srep
+}
+
+// newSlice -- construct a VString of length n that is a slice of
+// another string, allocating it and its representation at once
+func newSlice(n int, r srep) *VString {
+	p := &strSlot{VString{n: n}, r}
+	p.v.rep = unsafe.Pointer(&p.r)
+	return &p.v
 }
 
 // NewString -- construct a Goaldi string from a Go UTF8 string
 func NewString(s string) *VString {
-	return RuneString([]rune(s))
+	if !utf8.ValidString(s) {
+		return RuneString([]rune(s)) // each bad byte becomes U+FFFD
+	}
+	return newString(utf8.RuneCountInString(s), &srep{utf8: true, str: s})
 }
 
 const rString = 20                // declare sort ranking
@@ -59,20 +127,18 @@ func RuneString(r []rune) *VString {
 		h |= c
 		low[i] = uint8(c)
 		high[i] = uint16(c >> 8)
-		i++
 	}
 	if (h >> 8) == 0 {
-		return &VString{low, nil}
-	} else {
-		return &VString{low, high}
+		high = nil
 	}
+	return newString(n, &srep{flat: true, low: low, high: high})
 }
 
 // BinaryString -- construct a Goaldi string from Go Latin1 bytes
 func BinaryString(s []byte) *VString {
 	low := make([]uint8, len(s))
 	copy(low, s)
-	return &VString{low, nil}
+	return newString(len(s), &srep{flat: true, low: low})
 }
 
 // ToString(x) -- convert arbitrary value to String.
@@ -80,7 +146,7 @@ func BinaryString(s []byte) *VString {
 func ToString(x Value) *VString {
 	defer func() {
 		if recover() != nil {
-			panic(NewExn("String expected", x))
+			panic(NewErr(ErrString, x))
 		}
 	}()
 	return x.(Stringable).ToString()
@@ -88,27 +154,42 @@ func ToString(x Value) *VString {
 
 // VString.ToUTF8 -- convert Goaldi Unicode string to Go UTF8 string
 func (v *VString) ToUTF8() string {
-	b := make([]byte, 0, len(v.low))
-	p := make([]byte, 8)
-	for i, c := range v.low {
-		r := rune(c)
-		if v.high != nil {
-			r |= rune(v.high[i]) << 8
+	r := v.get()
+	if r.utf8 {
+		return r.str
+	}
+	var b strings.Builder
+	if r.flat {
+		b.Grow(v.n)
+		for i, c := range r.low {
+			c := rune(c)
+			if r.high != nil {
+				c |= rune(r.high[i]) << 8
+			}
+			b.WriteRune(c)
 		}
-		n := utf8.EncodeRune(p, r)
-		b = append(b, p[:n]...)
+	} else {
+		v.pieces(func(p *VString) {
+			b.WriteString(p.ToUTF8())
+		})
 	}
-	return string(b)
+	s := b.String()
+	if r.flat {
+		v.set(&srep{flat: true, low: r.low, high: r.high, utf8: true, str: s})
+	} else {
+		v.set(&srep{utf8: true, str: s}) // release the rope
+	}
+	return s
 }
 
 // VString.ToRunes() -- convert Goaldi Unicode string to array of Go runes
 func (v *VString) ToRunes() []rune {
-	n := len(v.low)
-	r := make([]rune, n)
-	for i := 0; i < n; i++ {
-		c := rune(v.low[i])
-		if v.high != nil {
-			c |= rune(v.high[i]) << 8
+	f := v.flatten()
+	r := make([]rune, v.n)
+	for i := range r {
+		c := rune(f.low[i])
+		if f.high != nil {
+			c |= rune(f.high[i]) << 8
 		}
 		r[i] = c
 	}
@@ -117,8 +198,8 @@ func (v *VString) ToRunes() []rune {
 
 // VString.ToBinary -- convert Goaldi Unicode to 8-bit bytes by truncation
 func (v *VString) ToBinary() []byte {
-	b := make([]byte, len(v.low))
-	copy(b, v.low)
+	b := make([]byte, v.n)
+	copy(b, v.flatten().low)
 	return b
 }
 
@@ -139,22 +220,28 @@ func (v *VString) ToString() *VString {
 
 // VString.TryNumber -- return conversion to VNumber or nil
 func (v *VString) TryNumber() *VNumber {
-	if v.high != nil { // if has exotic characters
-		return nil // it can't be valid
-	}
-	f, e := ParseNumber(string(v.low))
+	n, e := ParseNumber(v.ToUTF8())
 	if e == nil {
-		return NewNumber(f)
+		return n.(Numerable).ToNumber()
 	} else {
 		return nil
 	}
 }
 
+// VString.toNumeric -- return exact conversion to VNumber or VBigInt,
+// or throw Exception
+func (v *VString) toNumeric() Value {
+	if n, e := ParseNumber(v.ToUTF8()); e == nil {
+		return n
+	}
+	panic(NewErr(ErrConvert, v))
+}
+
 // VString.ToNumber -- return conversion to VNumber, or throw Exception
 func (v *VString) ToNumber() *VNumber {
 	n := v.TryNumber()
 	if n == nil {
-		panic(NewExn("Cannot convert to number", v))
+		panic(NewErr(ErrConvert, v))
 	} else {
 		return n
 	}
@@ -225,7 +312,7 @@ func (ss *vSubStr) Assign(v Value) IVariable {
 	src := Deref(tgt).(*VString)
 	ins := ToString(v)
 	if ss.j > src.length() {
-		panic(NewExn("String shrunk before assignment complete", ss))
+		panic(NewErr(ErrShrunk, ss))
 	}
 	snew := scat(src, 0, ss.i, ins, 0, ins.length(), src, ss.j, src.length())
 	ss.target = tgt.Assign(snew)
@@ -235,9 +322,77 @@ func (ss *vSubStr) Assign(v Value) IVariable {
 
 //  -------------------------- internal functions ---------------------
 
+// VString.get -- return the current representation
+func (s *VString) get() *srep {
+	return (*srep)(atomic.LoadPointer(&s.rep))
+}
+
+// VString.set -- replace the current representation
+func (s *VString) set(r *srep) {
+	atomic.StorePointer(&s.rep, unsafe.Pointer(r))
+}
+
+// VString.flatten -- return a representation that includes the flat form,
+// computing and recording it if necessary
+func (s *VString) flatten() *srep {
+	r := s.get()
+	if r.flat {
+		return r
+	}
+	f := &srep{flat: true, utf8: r.utf8, str: r.str}
+	if r.utf8 && len(r.str) == s.n {
+		f.low = []uint8(r.str) // all ASCII
+	} else if r.utf8 {
+		f.low = make([]uint8, s.n)
+		i := 0
+		for _, c := range r.str {
+			f.low[i] = uint8(c)
+			if c > 0xFF {
+				if f.high == nil {
+					f.high = make([]uint16, s.n)
+				}
+				f.high[i] = uint16(c >> 8)
+			}
+			i++
+		}
+	} else {
+		// copy the pieces of the rope
+		f.low = make([]uint8, s.n)
+		i := 0
+		s.pieces(func(p *VString) {
+			q := p.flatten()
+			copy(f.low[i:], q.low)
+			if q.high != nil {
+				if f.high == nil {
+					f.high = make([]uint16, s.n)
+				}
+				copy(f.high[i:], q.high)
+			}
+			i += p.n
+		})
+	}
+	s.set(f)
+	return f
+}
+
+// VString.pieces(f) -- call f for each of the non-rope strings that
+// make up a rope, in order, without recursion (ropes can be very deep)
+func (s *VString) pieces(f func(*VString)) {
+	stack := []*VString{s}
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if r := p.get(); r.left != nil && !r.flat && !r.utf8 {
+			stack = append(stack, r.right, r.left)
+		} else if p.n > 0 {
+			f(p)
+		}
+	}
+}
+
 // VString.length -- return string length as int
 func (s *VString) length() int {
-	return len(s.low)
+	return s.n
 }
 
 // VString.slice -- return substring given Go-style zero-based limits
@@ -246,31 +401,54 @@ func (s *VString) slice(lval Value, i int, j int) Value {
 	if lval != nil {
 		return &vSubStr{lval, i, j} // produce variable
 	}
-	// produce value
-	r := &VString{s.low[i:j], nil}
-	if s.high != nil && j > i {
-		for k := i; k < j; k++ {
-			if s.high[k] != 0 {
-				// need high array in new slice, too
-				r.high = s.high[i:j]
-				break
-			}
+	return s.substr(i, j) // produce value
+}
+
+// VString.substr -- return substring value, sharing storage with s
+func (s *VString) substr(i int, j int) *VString {
+	if i == 0 && j == s.n {
+		return s
+	}
+	r := s.get()
+	if r.utf8 && len(r.str) == s.n && !r.flat {
+		// ASCII string: slice the Go string
+		if j-i == 1 {
+			return chars[r.str[i]]
 		}
+		return newSlice(j-i, srep{utf8: true, str: r.str[i:j]})
 	}
-	return r
+	r = s.flatten()
+	if j-i == 1 && (r.high == nil || r.high[i] == 0) {
+		return chars[r.low[i]]
+	}
+	t := srep{flat: true, low: r.low[i:j]}
+	if r.high != nil {
+		t.high = r.high[i:j]
+	}
+	if r.utf8 && len(r.str) == s.n {
+		t.utf8 = true
+		t.str = r.str[i:j]
+	}
+	return newSlice(j-i, t)
 }
 
 // VString.compare -- compare two strings, return <0, 0, or >0
 func (s *VString) compare(t *VString) int {
-	// check for easy case
+	// check for easy cases
 	if s == t {
 		return 0
 	}
+	if rs, rt := s.get(), t.get(); rs.utf8 && rt.utf8 {
+		// UTF-8 byte order is the same as code point order
+		return strings.Compare(rs.str, rt.str)
+	}
 	// extract fields
-	sl := s.low
-	tl := t.low
-	sh := s.high
-	th := t.high
+	fs := s.flatten()
+	ft := t.flatten()
+	sl := fs.low
+	tl := ft.low
+	sh := fs.high
+	th := ft.high
 	sn := len(sl)
 	tn := len(tl)
 	// compare runes until one differs
@@ -278,10 +456,10 @@ func (s *VString) compare(t *VString) int {
 		sr := rune(sl[i])
 		tr := rune(tl[i])
 		if sh != nil {
-			sr |= rune(sh[i] << 8)
+			sr |= rune(sh[i]) << 8
 		}
 		if th != nil {
-			tr |= rune(th[i] << 8)
+			tr |= rune(th[i]) << 8
 		}
 		if sr != tr {
 			return int(sr) - int(tr)
@@ -291,38 +469,37 @@ func (s *VString) compare(t *VString) int {
 	return sn - tn
 }
 
+// concat -- concatenate two strings.
+// Short results are copied; long ones produce a rope.
+func concat(s *VString, t *VString) *VString {
+	if s.n == 0 {
+		return t
+	} else if t.n == 0 {
+		return s
+	}
+	n := s.n + t.n
+	if n >= ROPEMIN {
+		return newString(n, &srep{left: s, right: t})
+	}
+	fs := s.flatten()
+	ft := t.flatten()
+	low := make([]uint8, n)
+	copy(low, fs.low)
+	copy(low[s.n:], ft.low)
+	var high []uint16
+	if fs.high != nil || ft.high != nil {
+		high = make([]uint16, n)
+		copy(high, fs.high)
+		copy(high[s.n:], ft.high)
+	}
+	return newString(n, &srep{flat: true, low: low, high: high})
+}
+
 // scat -- general string concatenator.
 // produces x1[i1:j1] || s2[i2:j2] || s3[i3:j3]  (using Go indexing).
 // all arguments are assumed valid.
 func scat(s1 *VString, i1, j1 int, s2 *VString, i2, j2 int,
 	s3 *VString, i3, j3 int) *VString {
-	n1 := j1 - i1
-	n2 := j2 - i2
-	n3 := j3 - i3
-	nt := n1 + n2 + n3
-	low := make([]uint8, nt)
-	copy(low[0:], s1.low[i1:j1])
-	copy(low[n1:], s2.low[i2:j2])
-	copy(low[n1+n2:], s3.low[i3:j3])
-	if s1.high == nil && s2.high == nil && s3.high == nil {
-		return &VString{low, nil}
-	}
-	high := make([]uint16, nt)
-	if s1.high != nil {
-		copy(high[0:], s1.high[i1:j1])
-	}
-	if s2.high != nil {
-		copy(high[n1:], s2.high[i2:j2])
-	}
-	if s3.high != nil {
-		copy(high[n1+n2:], s3.high[i3:j3])
-	}
-	for k := 0; k < nt; k++ {
-		if high[k] != 0 {
-			// yes, needed high array
-			return &VString{low, high}
-		}
-	}
-	// no high array needed
-	return &VString{low, nil}
+	return concat(concat(s1.substr(i1, j1), s2.substr(i2, j2)),
+		s3.substr(i3, j3))
 }
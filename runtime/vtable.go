@@ -4,15 +4,20 @@ package runtime
 
 import (
 	"bytes"
+	"container/list"
 	"fmt"
 )
 
 //		A Goaldi table combines a default value with a Go map[Value]Value under
 //	 the name of VTable.  Goaldi string and number indexes are converted to Go
 //		Go string and float64 values by the GoKey() function (also used for sets).
+//	 A table keyed by value also indexes its structured keys (see keyIndex).
+//	 An ordered table also records the order of its keys (see keyOrder).
 type VTable struct {
 	data  map[Value]Value // underlying Go map
 	dfval Value           // default value
+	index keyIndex        // structured keys, if keyed by value
+	order *keyOrder       // order of keys, if ordered
 }
 
 const rTable = 70       // declare sort ranking
@@ -20,12 +25,63 @@ var _ ICore = &VTable{} // validate implementation
 
 // NewTable -- construct a new Goaldi table
 func NewTable(dfval Value) *VTable {
-	return &VTable{make(map[Value]Value), dfval}
+	return &VTable{make(map[Value]Value), dfval, nil, nil}
+}
+
+// NewValueTable -- construct a new Goaldi table keyed by value
+func NewValueTable(dfval Value) *VTable {
+	return &VTable{make(map[Value]Value), dfval, make(keyIndex), nil}
+}
+
+// VTable.empty() returns a new, empty table with the same default value,
+// keyed and ordered in the same way as T
+func (T *VTable) empty() *VTable {
+	r := NewTable(T.dfval)
+	if T.index != nil {
+		r = NewValueTable(T.dfval)
+	}
+	if T.order != nil {
+		r.order = newKeyOrder()
+	}
+	return r
+}
+
+// VTable.put(k, v) adds or replaces the entry for key k
+func (T *VTable) put(k Value, v Value) {
+	key := T.index.insert(k)
+	if _, ok := T.data[key]; !ok {
+		T.order.add(key)
+	}
+	T.data[key] = v
+}
+
+// VTable.keys() returns the underlying map keys of T,
+// in order if T is ordered
+func (T *VTable) keys() []interface{} {
+	if T.order != nil {
+		return T.order.keys()
+	}
+	keys := make([]interface{}, 0, len(T.data))
+	for k := range T.data {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// VTable.entries() returns the contents of T as a list of
+// elemtype(key,value) records, in order if T is ordered
+func (T *VTable) entries() []Value {
+	keys := T.keys()
+	l := make([]Value, len(keys))
+	for i, k := range keys {
+		l[i] = ElemType.New([]Value{Import(k), T.data[k]})
+	}
+	return l
 }
 
 // TableType is the table instance of type type.
 var TableType = NewType("table", "T", rTable, Table, TableMethods,
-	"table", "x", "create a table with default value x")
+	"table", "x,byvalue,ordered", "create a table with default value x")
 
 // VTable.String -- default conversion to Go string returns "T:size"
 func (T *VTable) String() string {
@@ -34,17 +90,28 @@ func (T *VTable) String() string {
 
 // VTable.GoString -- convert to Go string for image() and printf("%#v")
 //
-// For utility and reproducibility, we pay the cost to sort into key order.
+// For utility and reproducibility, we pay the cost to sort into key order,
+// unless the table is ordered.
 func (T *VTable) GoString() string {
+	return T.Image(1)
+}
+
+// VTable.Image -- returns image with keys and values shown to depth d-1
+func (T *VTable) Image(d int) string {
 	if len(T.data) == 0 {
 		return "table{}"
 	}
-	l, _ := T.Sort(ONE) // sort on key values
+	l := T.entries()
+	if T.order == nil {
+		v, _ := T.Sort(ONE) // sort on key values
+		l = v.(*VList).data
+	}
 	var b bytes.Buffer
 	fmt.Fprintf(&b, "table{")
-	for _, e := range l.(*VList).data {
+	for _, e := range l {
 		r := e.(*VRecord)
-		fmt.Fprintf(&b, "%v:%v,", r.Data[0], r.Data[1])
+		fmt.Fprintf(&b, "%s:%s,",
+			ImageDepth(r.Data[0], d-1), ImageDepth(r.Data[1], d-1))
 	}
 	s := b.Bytes()
 	s[len(s)-1] = '}'
@@ -62,6 +129,8 @@ func (T *VTable) Copy() Value {
 	for k, v := range T.data {
 		r.data[k] = v
 	}
+	r.index = T.index.copy()
+	r.order = T.order.copy()
 	return r
 }
 
@@ -80,3 +149,66 @@ func (T *VTable) Import() Value {
 func (T *VTable) Export() interface{} {
 	return T.data
 }
+
+// A keyOrder records the order of the keys of an ordered table,
+// from first to last, as a list of underlying map keys.
+// The nil keyOrder, used by other tables, records nothing.
+type keyOrder struct {
+	list  *list.List                    // keys in order
+	elems map[interface{}]*list.Element // list element holding each key
+}
+
+// newKeyOrder() returns a new, empty keyOrder
+func newKeyOrder() *keyOrder {
+	return &keyOrder{list.New(), make(map[interface{}]*list.Element)}
+}
+
+// keyOrder.add(k) adds key k at the end if not already present
+func (ko *keyOrder) add(k interface{}) {
+	if ko != nil && ko.elems[k] == nil {
+		ko.elems[k] = ko.list.PushBack(k)
+	}
+}
+
+// keyOrder.remove(k) removes key k, if present
+func (ko *keyOrder) remove(k interface{}) {
+	if ko != nil && ko.elems[k] != nil {
+		ko.list.Remove(ko.elems[k])
+		delete(ko.elems, k)
+	}
+}
+
+// keyOrder.move(k, front) moves key k to the front or back,
+// returning false if k is not present
+func (ko *keyOrder) move(k interface{}, front bool) bool {
+	e := ko.elems[k]
+	if e == nil {
+		return false
+	} else if front {
+		ko.list.MoveToFront(e)
+	} else {
+		ko.list.MoveToBack(e)
+	}
+	return true
+}
+
+// keyOrder.keys() returns the keys in order
+func (ko *keyOrder) keys() []interface{} {
+	keys := make([]interface{}, 0, ko.list.Len())
+	for e := ko.list.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value)
+	}
+	return keys
+}
+
+// keyOrder.copy() returns a duplicate keyOrder
+func (ko *keyOrder) copy() *keyOrder {
+	if ko == nil {
+		return nil
+	}
+	r := newKeyOrder()
+	for _, k := range ko.keys() {
+		r.add(k)
+	}
+	return r
+}
@@ -134,16 +134,29 @@ func InstanceOf(env *Env, args ...Value) (Value, *Closure) {
 		}
 	}
 	// not an external
-	vtype := v.(ICore).Type() // get type of value
+	if IsInstance(v, t) {
+		return Return(v)
+	}
+	// no match at all
+	return Fail()
+}
+
+// IsInstance(v, t) reports whether Goaldi value v is of type t,
+// either directly or, for a record, by way of an ancestor type.
+func IsInstance(v Value, t IRank) bool {
+	c, ok := v.(ICore)
+	if !ok {
+		return false
+	}
+	vtype := c.Type() // get type of value
 	if vtype == t {
-		return Return(v) // exact match
+		return true // exact match
 	}
 	// no match, but check ancestor classes if a record type
 	for c, _ := vtype.(*VCtor); c != nil; c = c.Parent {
 		if c == t {
-			return Return(v) // found a match
+			return true // found a match
 		}
 	}
-	// no match at all
-	return Fail()
+	return false
 }
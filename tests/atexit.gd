@@ -0,0 +1,12 @@
+#SRC: goaldi original
+#
+#	test termination procedures registered by atexit()
+
+procedure main() {
+	atexit(lambda() write("first registered, called last"))
+	atexit(lambda() throw("failing termination procedure"))
+	atexit(lambda() { write("calling exit"); exit(0) })
+	atexit(lambda() write("last registered, called first"))
+	writes("main ")
+	write("returns")
+}
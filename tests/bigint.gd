@@ -0,0 +1,38 @@
+#  bigint.gd -- test arbitrary-precision integers
+
+procedure fact(n) {
+	local f := 1
+	every f *:= 2 to n
+	return f
+}
+
+procedure main() {
+	every write(fact(20 | 21 | 30))
+	local b := 2 ^ 100
+	write(b, " ", image(b), " ", type(b), " ", *b)
+	write(b + 1, " ", b - 1, " ", -b, " ", b * b)
+	write(b / 2 ^ 98, " ", b // 3, " ", b % 3, " ", b / 3)
+	write(b - (b - 5), " ", type(b - b), " ", b // b)
+	local x := 123456789012345678901234567890
+	write(x, " ", x + 1, " ", "98765432109876543210" + 0)
+	write(number("  -123456789012345678901234567890  "))
+	write(number("FFFFFFFFFFFFFFFFFFFFx"), " ", 36r1234567890abcdefghij)
+	write(9007199254740993 - 9007199254740992)
+	write(2 ^ 64 > 2 ^ 63 | "no", " ", 2 ^ 63 < 1e300 | "no", " ",
+		2 ^ 63 = 9223372036854775808 | "no", " ", b ~= b | "no")
+	write(abs(-b), " ", integer(b), " ", 7 ^ 0.5 < 3 | "no")
+	every write(![3, b, -b, 2.5, b + 1, "a"].sort())
+	local S := set([b, 2 ^ 100, b + 0, 5])
+	write(*S, " ", S[2 ^ 100] | "none")
+	local T := table()
+	T[b] := "big"
+	write(T[2 ^ 100], " ", image(T))
+	write(b || "!", " ", b[1:4])
+	write(2 ^ 53 + 1, " ", (2 ^ 53 + 1) - 2 ^ 53)
+
+	# large floats are inexact and stay floating
+	write(1e300 * 2, " ", 1e308 * 10, " ", -1e308 * 10, " ", 1e20 + 1)
+	write(type(1e20 + 1), " ", equal(1e20 + 1, 1e20) | "no", " ",
+		(1e20 + 1 = 1e20) | "no", " ", 1e16 * 3, " ", 1e200 * 2 ^ 100)
+	write(b * 1e300, " ", b + 1e20, " ", 1e300 // 7, " ", b * 0.5)
+}
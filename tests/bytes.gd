@@ -0,0 +1,60 @@
+#SRC: goaldi original
+#
+#	test bytes values
+
+procedure main() {
+	local b := bytes("héllo")
+	write(type(b), " ", *b, " ", image(b), " ", b)
+	write(b[1], " ", b[2], " ", b[-1], " ", image(b[2:4]), " ", image(b[-2:0]),
+		" ", b[9] | "none")
+	every writes(" ", !b)
+	write()
+	write(b.decode(), " ", image(b.decode("latin-1")), " ", image(b.decode("ASCII")))
+	write(image(b || bytes([33, 10])), " ", image(bytes()), " ", *bytes(b))
+
+	# repeated concatenation, and branches from a shared prefix
+	local c := bytes()
+	every local i := 1 to 100000 do
+		c ||:= bytes([i % 256])
+	write(*c, " ", c[1], " ", c[-1], " ", c[256], " ", c[257])
+	local p := b || bytes("!")
+	local q := p || bytes("?")
+	local r := p || bytes(".")
+	write(image(p), " ", image(q), " ", image(r), " ", image(q || r))
+
+	# encodings
+	local s := "A€𝄞"
+	every local e := "utf-8" | "utf-16be" | "UTF_16LE" do {
+		local x := bytes(s, e)
+		write(e, ": ", *x, " ", image(x), " ", x.decode(e) == s)
+	}
+	write(image(bytes("ÿ", "latin1")), " ", image(bytes("abc", "ascii")))
+	write(image(bytes([255, 0, 128]).decode()), " ",
+		image(bytes([0, 65, 0]).decode("utf-16be")))
+
+	# comparison, sorting, sets, tables
+	write(bytes("abc") === bytes("abc"), " ", bytes("abc") === "abc" | "differ")
+	every writes(" ", image(![bytes("b"), bytes("a"), "s", 1].sort()))
+	write()
+	local S := set([bytes("x"), bytes("x"), bytes("y")])
+	write(*S, " ", image(S[bytes("y")]))
+
+	# errors
+	try(lambda() bytes([256]))
+	try(lambda() bytes("x", "ebcdic"))
+	try(lambda() bytes("€", "latin1"))
+	try(lambda() bytes("a") || "b")
+
+	# binary input
+	local f := file("io.dat")
+	local x := f.readb(6, bytes)
+	write(type(x), " ", image(x), " ", image(f.readb(4)))
+	f.close()
+	%stdout.writeb(bytes([104, 105, 10]))
+	write(bytes("raw write"))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
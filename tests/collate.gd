@@ -0,0 +1,58 @@
+#SRC: goaldi original
+#
+#	test locale-aware string collation
+
+record person(name, city)
+
+procedure main() {
+	local W := ["Zebra", "apple", "Äpfel", "zoo", "Érable", "eagle", "Ålborg",
+		"öl", "Öre", "Apple", "ñame", "nudo", "oso", "côte", "cote", "coté"]
+	write(image(W.sort()))
+	local C := collate()
+	write(image(C), " ", C.locale(), " ", C.strength(), " ", type(C).name())
+	write(image(W.sort(C)))
+	write(image(W.sort(, C.key)))
+	write(image(W.sort(C, , 1)))
+	every local loc := "sv_SE.UTF-8" | "da" | "es-ES" | "fr" do {
+		local L := collate(loc)
+		write(right(L.locale(), 5), ": ", image(W.sort(, L.key)))
+	}
+
+	# comparison
+	every local s := 1 to 3 do {
+		local D := collate("de", s)
+		writes(s, ":")
+		every local p := ["resume", "résumé"] | ["resume", "RESUME"] |
+				["Résumé", "resumes"] | ["é", "é"] do
+			writes(" ", D.compare(p[1], p[2]))
+		write()
+	}
+	write(C("a", "B") | "fail", " ", C("B", "a") | "fail", " ",
+		C("a", "a") | "fail", " ", ("a" << "B") | "fail")
+	write(type(C.key("Ab")), " ", image(collate(, 1).key("Ab")), " ",
+		((collate(, 1).key("ab") === collate(, 1).key("AB")) & "same") | "fail")
+
+	# keys of records, and other orderings
+	local P := [person("Östen", "Umeå"), person("Ada", "Oslo"),
+		person("Åsa", "Åre"), person("Zoë", "Bergen"), person("ola", "Aalborg")]
+	every writes(" ", (!P.sort(collate("sv"), lambda(p) p.name)).name)
+	write()
+	every writes(" ", (!P.sort([collate("sv", 1), lambda(x, y) x << y],
+		lambda(p) p.city)).city)
+	write()
+	local Q := pqueue(collate("sv"))
+	every Q.put(!W)
+	every writes(" ", |Q.get())
+	write()
+
+	# errors
+	try(lambda() collate("sv", 0))
+	try(lambda() collate("sv", 4))
+	try(lambda() C(a: "x", b: "y"))
+	try(lambda() C.compare("x", []))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(image(p()))
+}
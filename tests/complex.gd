@@ -0,0 +1,33 @@
+#SRC: goaldi original
+#
+#	test complex numbers
+
+procedure main() {
+	local z := complex("3+4i")
+	write(z, " ", image(z), " ", type(z), " ", abs(z), " ", *z)
+	write(z.real(), " ", z.imag(), " ", z.conj(), " ", z.phase())
+	write(complex(1, -2), " ", complex(2.5), " ", complex("-2.5i"),
+		" ", complex("(1-1i)"), " ", complex("bogus") | "fail")
+	write(z + 1, " ", 1 - z, " ", z * z, " ", z / 2, " ", -z, " ", z * "2")
+	write(z * complex(0, 1), " ", complex(0, 1) ^ 2, " ", 2 ^ complex(0, 1))
+	write(z + rational(1, 2), " ", z + decimal("0.25"), " ", z + 2 ^ 60)
+	write(sqrt(complex(-4)), " ", sqrt(-4), " ", exp(complex(0, %pi)))
+	write(log(complex(-1)), " ", log(z, 10), " ", sin(complex(0, 1)))
+	write(z = complex(3, 4) | "no", " ", z ~= 5 | "no",
+		" ", complex(5, 0) = 5 | "no", " ", z === complex(3, 4) | "no")
+	write(z || "!", " ", z[1:3])
+	write(sprintf("%v|%.2f|%g", z, z, z))
+	every writes(" ", image(![z, 2, complex(1, 9), complex(1, -1), "a",
+		rational(1, 3)].sort()))
+	write()
+	local S := set([z, complex(3, 4), complex(4, 3)])
+	write(*S, " ", S[complex("3+4i")] | "none")
+	try(lambda() z < 5)
+	try(lambda() z // 2)
+	try(lambda() integer(z))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
@@ -0,0 +1,43 @@
+#SRC: goaldi original
+#
+#	test character sets
+
+procedure main() {
+	local c := cset("hello, world")
+	write(type(c), " ", *c, " ", c, " ", image(c))
+	write(image(cset()), " ", image(cset("abc", "xyz", cset("m"))),
+		" ", image(%letters), " ", *%ascii)
+	every writes(" ", !cset("zyx321"))
+	write()
+	write(c["o"] | "none", " ", c["z"] | "none", " ", c["lo"] | "none")
+
+	# set operations, with csets or strings on the right
+	local v := cset("aeiou")
+	write(image(c ++ v), " ", image(c ** v), " ", image(c -- v))
+	write(image(%letters -- "aeiouAEIOU" ** %lcase), " ", *(%letters ++ %digits))
+	write(c ++ "" === c | "no", " ", v === cset("uoiea") | "no",
+		" ", v === "aeiou" | "no")
+
+	# Unicode categories
+	local greek := category("Greek")
+	local upper := category("Lu")
+	write(greek["λ"] | "no", " ", upper["Λ"] | "no", " ", upper["λ"] | "no",
+		" ", *(greek ** upper ** cset("ΑΒΓαβγ")), " ", category("Xyz") | "fail")
+	write(*category("Nd") > 100 | "no", " ", category("White_Space")["\t"] | "no")
+
+	# use in string functions
+	write("[", trim("  padded  "), "] [", trim("--x-y--", "-"), "] [",
+		trim("123abc456", %digits), "]")
+	write(map("Hello World", %ucase, %lcase), " ", map("a1b2c3", %digits, "###"))
+	local y := scanner("count: 42 items")
+	write(y.tab(y.upto(%digits)), "|", y.tab(y.many(%digits)), "|",
+		y.tab(y.upto(category("L"))), "|", y.tab(0))
+
+	# sorting and keys
+	every writes(" ", image(![cset("b"), cset("abc"), cset("ab"), cset("ac"),
+		"s", 1].sort()))
+	write()
+	local S := set([cset("ab"), cset("ba"), cset("abc")])
+	write(*S, " ", image(S[cset("ab")]) | "none")
+	write(?cset("q"), " ", *cset(?%lcase), " ", c || "!")
+}
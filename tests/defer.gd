@@ -0,0 +1,69 @@
+#SRC: goaldi original
+#
+#	test deferred cleanup ("defer e")
+
+procedure main() {
+	write("returned ", normal())
+	write("failed ", failing() | "[FAILED]")
+	write("caught ", catching())
+	every write("every ", generator(2))
+	write("limited ", generator(5) \ 1)
+	outer()
+	write("resumed ", throwing())
+	local f := file("defer1.tmp", "w")
+	writer(f)
+	write("file ", read(file("defer1.tmp")))
+}
+
+procedure normal() {
+	local s := "a"
+	defer write("   cleanup 1, s=", s)
+	defer write("   cleanup 2, s=", s)
+	s := "b"
+	return s
+}
+
+procedure failing() {
+	defer write("   cleanup on failure")
+	fail
+}
+
+procedure catching() {
+	catch lambda(e) { write("   handler: ", e.msg); return "handled" }
+	defer write("   cleanup after handler")
+	throw("oops")
+}
+
+procedure generator(n) {
+	defer write("   generator ", n, " cleanup")
+	suspend 1 to n
+}
+
+procedure outer() {
+	catch lambda(e) write("   outer caught: ", e.msg)
+	inner()
+}
+
+procedure inner() {
+	defer write("   inner cleanup 1")
+	defer { write("   inner cleanup 2 throws"); throw("second") }
+	defer write("   inner cleanup 3")
+	throw("first")
+}
+
+procedure throwing() {
+	catch lambda(e) e.msg
+	every local x := thrower() do
+		write("   got ", x)
+}
+
+procedure thrower() {
+	defer write("   thrower cleanup")
+	suspend 1
+	throw("thrown after resumption")
+}
+
+procedure writer(f) {
+	defer f.close()
+	f.write("written and closed")
+}
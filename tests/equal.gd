@@ -0,0 +1,68 @@
+#SRC: goaldi original
+#
+#	test deepcopy(), equal(), and hash()
+
+record point(x, y)
+record other(x, y)
+
+procedure main() {
+	# scalar values
+	write(equal(1, 1.0), " ", equal("abc", "a" || "bc"), " ",
+		equal(1, "1") | "differ", " ", equal(nil, nil) | "differ")
+	write(samehash(1, 1.0), " ", samehash(0, -0.0), " ",
+		samehash("abc", "a" || "bc"), " ", samehash(2^70, 2^69 * 2))
+
+	# structures
+	local L := [1, [2, "x"], point(3, set([4]))]
+	local C := deepcopy(L)
+	write(C === L | "distinct", " ", C[2] === L[2] | "distinct", " ",
+		C[3] === L[3] | "distinct", " ", type(C[3]))
+	write(image(C, 5))
+	write(equal(L, C) === C, " ", samehash(L, C))
+	C[3].y.put(5)
+	write(equal(L, C) | "differ")
+	write(equal([], []), " ", equal([1], [1, 2]) | "differ", " ",
+		equal(point(1, 2), other(1, 2)) | "differ", " ",
+		equal([1], set([1])) | "differ")
+	write(equal(set([[1], "a"]), set(["a", [1]])), " ",
+		equal(set([[1], [1]]), set([[1]])), " ",
+		equal(set([[1]]), set([[2]])) | "differ", " ",
+		samehash(set([[1], [1]]), set([[1]])))
+	local T := table(0)
+	T[[1]] := "a"
+	T["k"] := [2]
+	local U := deepcopy(T)
+	write(equal(T, U), " ", samehash(T, U), " ", U["missing"])
+	U["k"] := [3]
+	write(equal(T, U) | "differ", " ", equal(table(0), table(1)) | "differ")
+
+	# sharing and cycles
+	local a := [1]
+	local b := [a, a]
+	local c := deepcopy(b)
+	write(c[1] === c[2] | "unshared", " ", c[1] === a | "distinct")
+	local n := point(1)
+	n.y := n
+	local m := deepcopy(n)
+	write(m.y === m, " ", m === n | "distinct", " ", equal(m, n), " ",
+		samehash(m, n))
+	local p := point(1, point(1))
+	p.y.y := p
+	write(equal(n, p), " ", samehash(n, p))
+	p.y.x := 2
+	write(equal(n, p) | "differ")
+	local S := set()
+	S.put(S)
+	write(equal(S, deepcopy(S)), " ", *deepcopy(S))
+
+	# other values are not copied
+	write(deepcopy(main) === main, " ", deepcopy("s"), " ", deepcopy(%stdout) === %stdout)
+	write(equal(main, main), " ", equal(main, write) | "differ", " ",
+		samehash(main, main), " ", samehash(%stdout, %stdout))
+	write(type(hash([1, 2, 3])), " ", hash(nil) >= 0 & "nonnegative", " ",
+		samehash([1], [2]), " ", samehash("a", "b"))
+}
+
+procedure samehash(x, y) {
+	return (hash(x) = hash(y) & "samehash") | "diffhash"
+}
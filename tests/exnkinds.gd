@@ -0,0 +1,39 @@
+#SRC: goaldi original
+#
+#	test exception values, kinds, and rethrow
+
+record myerr extends exception(line)
+
+procedure main() {
+	show(lambda() 1 + [])
+	show(lambda() throw("plain", 1, 2))
+	show(lambda() 1 to 0 by 0)
+	show(lambda() file("/no/such/file"))
+	show(lambda() throw(myerr("mine", [7], line:42)))
+	show(lambda() ([] ++ 3))
+	show(lambda() rethrow(myerr("bad kind"), 3))
+	write()
+	write("kept: ", image(filter(lambda() file("/no/such/dir/x"))))
+	write("kept: ", image(filter(lambda() throw(myerr("kept", line:1)))))
+	catch lambda(e) write("outer: ", e.msg, " (", type(e), ")")
+	filter(lambda() throw("passed along"))
+}
+
+procedure show(p) {
+	catch lambda(e) {
+		write(type(e), ": ", e.msg)
+		write("   offending: ", image(e.offending))
+		write("   traceback: ", e.traceback[1] | "none")
+		write("   cause: ", type(e.cause))
+		write("   exception: ", type(e.instanceof(exception)) | "no")
+		write("   ioerror: ", type(e.instanceof(ioerror)) | "no")
+		write("   code: ", image(e.code))
+		if e.instanceof(myerr) then write("   line: ", e.line)
+	}
+	p()
+}
+
+procedure filter(p) {
+	catch lambda(e) rethrow(e, ioerror, myerr).msg
+	p()
+}
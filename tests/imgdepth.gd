@@ -0,0 +1,16 @@
+#  imgdepth.gd -- test image() with a depth argument
+
+record point(x, y)
+
+procedure main() {
+	local L := [1, "two", [3, [4, "five"]], point(6, [7])]
+	local T := table()
+	T["k"] := set(["v", [8]])
+	every local d := 0 to 4 do {
+		write(d, ": ", image(L, d))
+		write(d, ": ", image(T, d))
+	}
+	write(image(L))
+	write(L.image(3))
+	write(image("str", 0), " ", image("str", 5))
+}
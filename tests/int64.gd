@@ -0,0 +1,19 @@
+#  int64.gd -- test exact 64-bit integer operations
+
+procedure main() {
+	local h := 0FFFFFFFFFFFFFFFFx
+	write(h, " ", h - 1, " ", h // 16, " ", h % 1000)
+	write(iand(h, 0F0F0F0F0F0F0F0F0x), " ", ior(2 ^ 62, 1), " ",
+		ixor(h, 1), " ", iclear(h, 255))
+	write(ishift(1, 63), " ", ishift(1, 70), " ", ishift(-2 ^ 60, -58),
+		" ", ishift(2 ^ 64 + 1, -1))
+	write(icom(0), " ", icom(2 ^ 63), " ", iand(-1, 2 ^ 64 - 1))
+	write(9007199254740991 // 3, " ", 9007199254740993 // 2, " ",
+		-7 // 2, " ", 7 % -3, " ", 123456789012345678 % 1000)
+	write(sprintf("%d %x", 2 ^ 63 + 7, 2 ^ 64 - 1))
+	local t := now().UnixNano()
+	write(type(t), " ", *t, " ", (t = t + 0, "ok") | "no")
+	write(iand(7.9, 3.2), " ", icom(-1.5))
+	catch lambda(e) { write("caught: ", e, " [", e.code, "]") ; return }
+	write(iand(1, %pi * 1e308 * 10))
+}
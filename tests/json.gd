@@ -0,0 +1,71 @@
+#SRC: goaldi original
+#
+#	test JSON encoding and decoding
+
+record point(x, y)
+record item(name, tags, where)
+
+procedure main() {
+	# encoding
+	write(jsonencode(nil), " ", jsonencode(42), " ", jsonencode(-2.5),
+		" ", jsonencode(1e21), " ", jsonencode(2^70), " ", jsonencode(decimal("19.95")))
+	write(jsonencode("tab\tquote\"back\\nl\nctl\x01é€"))
+	write(jsonencode([1, "two", [3], []]))
+	write(jsonencode(set([3, 1, 2])))
+	local T := table()
+	T["b"] := 2
+	T["a"] := [1, nil, 0.5]
+	T[10] := "ten"
+	write(jsonencode(T))
+	write(jsonencode(point(1, 2)))
+	write(jsonencode(item("box", ["a", "b"], point(0, -1)), 2))
+	write(jsonencode(table(), "\t"), jsonencode([], 4))
+
+	# decoding
+	every local s := "null" | "17" | "-0.25" | "123456789012345678901234567890" |
+			"\"a\\u00e9\\ud834\\udd1e\\n\"" | "true" | "false" | " [1, [2, null]] " do
+		write(s, " => ", image(jsondecode(s)))
+	local t := jsondecode("{\"k\": \"v\", \"n\": [1, 2], \"o\": {}}")
+	write(type(t), " ", *t, " ", t["k"], " ", *t["n"], " ", type(t["o"]))
+	local p := jsondecode("{\"y\": 5, \"x\": 4, \"z\": 9}", point)
+	write(image(p))
+	local q := jsondecode("[{\"x\": 1}, {\"y\": 2}]", point)
+	write(image(q[1]), " ", image(q[2]))
+	local n := jsondecode("{\"x\": {\"y\": 1}, \"y\": [{\"x\": 2}]}", point)
+	write(type(n), " ", type(n.x), " ", n.x["y"], " ", type(n.y[1]), " ", n.y[1]["x"])
+
+	# round trip
+	local v := ["x", 3, ["y", nil], point("p", 0.5)]
+	local e := jsonencode(v)
+	write(e, " ", jsonencode(jsondecode(e)) == e)
+
+	# streaming from a file
+	every local x := jsondecode(%stdin) do
+		write(type(x), " ", jsonencode(x))
+
+	# errors
+	try(lambda() jsonencode(point))
+	try(lambda() jsonencode(%stdout))
+	try(lambda() jsonencode(1 / 0.0))
+	local U := table()
+	U[[]] := 1
+	try(lambda() jsonencode(U))
+	local D := table()
+	D[1] := "one"
+	D["1"] := "string one"
+	try(lambda() jsonencode(D))
+	local L := [1, 2]
+	L.put(L)
+	try(lambda() jsonencode(L))
+	try(lambda() jsondecode("[1, 2"))
+	try(lambda() jsondecode("{\"a\" 1}"))
+	try(lambda() jsondecode("[1] 2"))
+	try(lambda() jsondecode(""))
+	try(lambda() jsondecode("nul"))
+	try(lambda() jsondecode("{}", 3))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
@@ -0,0 +1,63 @@
+#SRC: goaldi original
+#
+#	test insertion-ordered tables
+
+record point(x, y)
+
+procedure main() {
+	local T := table(0, ordered:1)
+	every local k := !["pear", "apple", "fig", "banana", "cherry"] do
+		T[k] := *k
+	T["fig"] +:= 10
+	report(T)
+	T.delete("apple")
+	T["apple"] := 5
+	report(T)
+	T.tofront("banana")
+	T.toback("pear")
+	report(T)
+	write(image(T.tofront("kiwi")) | "not a key", " ", T["kiwi"])
+	every writes(" ", (!T.sort(2)).key)
+	write()
+	write(jsonencode(T))
+	local C := T.copy()
+	C.tofront("pear")
+	write(image(C), " ", image(T))
+	local D := deepcopy(T)
+	D["date"] := 4
+	write(image(D))
+	local E := deserialize(serialize(T))
+	E.toback("banana")
+	write(image(E))
+	every local e := !T do {
+		if e.key == "fig" then T.delete("apple")
+		writes(" ", e.key)
+	}
+	write()
+	while @T
+	write(*T, " ", image(T))
+
+	# ordered tables keyed by value
+	local V := table(, 1, 1)
+	V[point(2, 1)] := "a"
+	V[[3]] := "b"
+	V[point(1, 2)] := "c"
+	V[[3]] := "B"
+	V.tofront(point(1, 2))
+	write(image(V, 3))
+	write(image(deserialize(serialize(V)), 3))
+	write(image(jsonencode(table(ordered:1))), " ", image(table(, , 1)))
+
+	# unordered tables cannot be reordered
+	try(lambda() table().tofront(1))
+}
+
+procedure report(T) {
+	every writes(" ", (!T).key)
+	write(" : ", image(T))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
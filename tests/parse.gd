@@ -0,0 +1,50 @@
+#SRC: goaldi original
+#
+#	test parse(), which reads values back from their images
+
+record point(x, y)
+record empty()
+
+procedure main() {
+	# scalar values
+	every local x := nil | 0 | -17 | 2.5 | 1e300 | -1/0.0 | 2^70 |
+			rational(1, 3) | decimal("1.50") | complex(1, -2) |
+			"a\"b\nሴ\x01𝄞" | "" | cset("cab") | bytes("a\x00\xffb") do {
+		local y := parse(image(x))
+		write(image(y), " ", type(y), " ", image(x) == image(y))
+	}
+
+	# structures
+	local T := table()
+	T["a"] := [1, "x", []]
+	T[2] := set([3, "three"])
+	T[point(1, 2)] := nil
+	every local v := [] | [1, [2, [3]]] | set() | table() | T | point(1, "y") |
+			point() | empty() | tuple(a:1, b:[2]) do {
+		local s := image(v, 10)
+		local y := parse(s)
+		write(s, " ", type(y), " ", s == image(y, 10))
+	}
+	local p := parse(" point{ y : 7 , x : \"a\" } ")
+	write(image(p), " ", type(p) === point)
+	write(image(parse("point{x:~}")), " ", image(parse("set{2,1,2}")))
+	write(parse("table{1:\"one\"}")[1])
+	local e := parse("exception{msg:\"oops\",code:99}")
+	write(type(e), " ", e.msg, " ", e.code)
+
+	# errors
+	try(lambda() parse(""))
+	try(lambda() parse("[1,2"))
+	try(lambda() parse("[1,x,L:0]"))
+	try(lambda() parse("1 2"))
+	try(lambda() parse("\"abc"))
+	try(lambda() parse("nowhere{a:1}"))
+	try(lambda() parse("point{z:1}"))
+	try(lambda() parse("main()"))
+	try(lambda() parse("rational(x)"))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
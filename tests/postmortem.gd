@@ -0,0 +1,35 @@
+#SRC: goaldi original
+#
+#	test the post-mortem dump written for an uncaught exception
+#
+#	The dump is written before termination procedures are called,
+#	so one of them copies it to standard output (omitting the line
+#	with the time) and then exits normally.
+
+record point(x, y)
+
+procedure main() {
+	atexit(lambda() {
+		local f := file("postmortem.tmp")
+		f.read()
+		while write(f.read())
+		f.close()
+		exit(0)
+	})
+	with %postmortem := "postmortem.tmp" do {
+		with %pmdepth := 1 do {
+			outer([1, [2, 3]], point(4, 5))
+		}
+	}
+}
+
+procedure outer(L, p) {
+	local n := *L
+	static calls := 0
+	calls +:= 1
+	return inner(p, "k")
+}
+
+procedure inner(p, key) {
+	return p.x + key
+}
@@ -0,0 +1,85 @@
+#SRC: goaldi original
+#
+#	test priority queues
+
+record task(pri, name)
+
+procedure main() {
+	local Q := pqueue()
+	Q.put(5, 3, 8, 1)
+	Q @: 4
+	write(*Q, " ", image(Q), " ", Q.peek(), " ", *Q, " ", type(Q), " ", Q)
+	every writes(" ", !Q)
+	write(" : ", *Q)
+	write(Q.get(), " ", @Q, " ", image(Q))
+	local C := Q.copy()
+	while writes(" ", Q.get())
+	write(" : ", *Q, " ", Q.peek() | "empty", " ", @Q | "empty", " ", *C)
+
+	# mixed types, ordered as by L.sort()
+	Q := pqueue().put("b", 2, nil, "a", 1.5, [3], [1])
+	while writes(" ", image(@Q))
+	write()
+
+	# ordering by field index
+	Q := pqueue(2)
+	Q.put(task(3, "write"), task(1, "alloc"), task(2, "read"))
+	while writes(" ", (@Q).name)
+	write()
+
+	# ordering by procedure
+	Q := pqueue(lambda(x, y) x > y)
+	Q.put(5, 3, 8, 1, 8)
+	write(image(Q))
+	Q := pqueue(lambda(x, y) (x.pri < y.pri) | (x.pri = y.pri & x.name << y.name))
+	Q.put(task(2, "c"), task(1, "b"), task(2, "a"), task(1, "z"))
+	while local t := @Q do
+		writes(" ", t.pri, t.name)
+	write()
+
+	# sharing among co-expressions
+	Q := pqueue()
+	local done := channel(4)
+	every local k := 1 to 4 do
+		create filler(Q, k * 100, done)
+	every 1 to 4 do @done
+	write(*Q)
+	local n := 0
+	local prev := 0
+	while local x := @Q do {
+		if x < prev then write("out of order: ", prev, " ", x)
+		prev := x
+		n +:= 1
+	}
+	write(n, " ", prev)
+
+	# errors
+	try(lambda() pqueue(0))
+	try(lambda() pqueue(lambda(x, y) x < y).put(1, "a"))
+
+	# an exception while ordering leaves the queue intact
+	local fussy := 0
+	local F := pqueue(lambda(x, y) {
+		if fussy = 1 then throw("too fussy", x, y)
+		return x < y
+	})
+	every F.put(7 | 3 | 9 | 1 | 5 | 8)
+	try(lambda() F.put("x"))
+	fussy := 1
+	try(lambda() F.put(0))
+	try(lambda() F.get())
+	fussy := 0
+	write(*F, " ", image(F))
+	every writes(" ", |F.get())
+	write()
+}
+
+procedure filler(Q, base, done) {
+	every Q.put(base + (1 to 25))
+	done @: base
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
@@ -0,0 +1,37 @@
+#SRC: goaldi original
+#
+#	test rational and decimal numbers
+
+procedure main() {
+	local q := rational("22/7")
+	write(q, " ", image(q), " ", type(q), " ", *q)
+	write(rational(1, 3) + rational(1, 6), " ", rational(1, 3) * 3,
+		" ", type(rational(1, 3) * 3))
+	write(rational(0.1), " ", rational("-1.25"), " ", rational(6, 4))
+	write(q + 1, " ", q - 3, " ", -q, " ", q / 2, " ", q // 1, " ", q % 1)
+	write(rational(2, 3) ^ 3, " ", rational(2, 3) ^ -2, " ", q + 0.5)
+	write(rational("x") | "fail", " ", rational(1, 0) | "fail")
+
+	local d := decimal("19.99")
+	write(d, " ", image(d), " ", type(d), " ", d * 3, " ", d + 0.01)
+	write(decimal("2.345", 2), " ", decimal("2.355", 2), " ",
+		decimal("-2.345", 2), " ", decimal(2.5, 0), " ", decimal(3.5, 0))
+	write(d * 1.08, " ", d / 3, " ", d // 1, " ", d % 1, " ", -d)
+	write(decimal("19.950"), " ", decimal(rational(1, 8)), " ",
+		decimal(rational(1, 3)) | "fail", " ", decimal(rational(1, 3), 4))
+	write(decimal(1, 2) + rational(1, 3), " ", d + q, " ", d ^ 2)
+	write(sprintf("%.2f|%v|%8.3f|%-7s|%#v|%g", d * 1.08, d, d, d, d, d))
+	write(sprintf("%v|%s|%.3f", q, q, decimal(q, 3)))
+	write(abs(-d), " ", abs(-q), " ", integer(-d), " ", integer(q))
+	write(d = 19.99 | "no", " ", q > 3.14 | "no", " ", q < 3.15 | "no",
+		" ", d === decimal("19.990") | "no", " ", d = decimal("19.990") | "no")
+
+	every writes(" ", image(![3, q, d, 2.5, rational(1, 2), decimal("0.5"),
+		2 ^ 70, -1, "a"].sort()))
+	write()
+	local S := set([q, rational(44, 14), d, decimal("19.99"), 1])
+	write(*S, " ", S[rational(22, 7)] | "none", " ", S[decimal("19.99")] | "none")
+
+	catch lambda(e) write("caught: ", e.msg, " ", e.code)
+	write(rational(1, 2) / 0)
+}
@@ -12,7 +12,7 @@ procedure main() {
 
 procedure try(i) {
 	catch lambda(e) {
-		write(i, ". ", e)
+		write(i, ". ", e, " [", \e.code | "-", "]")
 	}
 	provoke(i)
 }
@@ -98,6 +98,7 @@ procedure provoke(i) {
 		402: [] ||| 58
 		403: channel()[3]
 		404: [].sort(-1)
+		405: [].sort([])
 
 		# S : set
 		441: set([1,2]) ++ 441
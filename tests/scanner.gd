@@ -0,0 +1,56 @@
+#SRC: goaldi original
+#
+#	test string scanning with scanner values
+
+procedure main() {
+	local y := scanner("the quick brown fox")
+	write(type(y), " ", y, " ", image(y), " ", y.subject())
+	write(y.tab(y.upto(" ")), "|", y.pos(), "|", y.move(1), "|", y.tab(0))
+	write(y.pos(0) | "no", " ", y.pos(1) | "no", " ", image(copy(y)))
+
+	# words, using many() and upto()
+	local letters := "abcdefghijklmnopqrstuvwxyz"
+	y := scanner("  one two,three  four ")
+	while y.tab(y.upto(letters)) do writes("[", y.tab(y.many(letters)), "]")
+	write()
+
+	# backtracking restores the position
+	y := scanner("abcdef")
+	write(image(y.tab(3) & y.match("xyz")) | "failed", " at ", y.pos())
+	write(y.tab(y.find("c" | "e")) & y.match("ef") & y.pos(), " ", y.tab(0))
+	y := scanner("abcdef")
+	every write(y.tab(y.upto("bdf")), " @ ", y.pos())
+	write("after every: ", y.pos())
+	write(y.tab(-2) & y.move(-2) & y.tab(0), " ", y.pos() | "no",
+		" ", y.move(99) | "fail", " ", y.any("x") | "fail", " ", (y.tab(1) & y.any("a")))
+
+	# find all occurrences
+	y := scanner("abracadabra", 2)
+	every writes(" ", y.find("a"))
+	write()
+
+	# balanced expressions
+	y := scanner("f(a,(b,c)),g[1,2],h")
+	every writes(" ", y.bal(","))
+	write()
+	every writes(" ", y.bal(",", "([", ")]"))
+	write()
+	while write(y.tab(y.bal(",", "([", ")]") | 0)) do y.move(1) | break
+
+	# a small goal-directed parse of "key = value" pairs
+	every parse("alpha = 1" | "beta=22" | "=3" | "gamma = ")
+}
+
+procedure parse(s) {
+	local y := scanner(s)
+	local k
+	local v
+	if (k := y.tab(y.many("abcdefghijklmnopqrstuvwxyz"))) &
+		y.tab(y.many(" ") | y.pos()) & y.match("=") & y.move(1) &
+		y.tab(y.many(" ") | y.pos()) & (v := y.tab(y.many("0123456789"))) &
+		y.pos(0) then {
+		write("   ", k, " -> ", v)
+	} else {
+		write("   bad: ", image(s), " stopped at ", y.pos())
+	}
+}
@@ -0,0 +1,53 @@
+#SRC: goaldi original
+#
+#	test string searching procedures
+
+procedure main() {
+	# find
+	every writes(" ", find("a", "abracadabra"))
+	write()
+	every writes(" ", find("a", "abracadabra", 3, -3))
+	write()
+	every writes(" ", find("ab", "abracadabra", -4))
+	write()
+	write(find("x", "abc") | "none", " ", find("a", "abc", 9) | "range")
+	local n := 0
+	every find("ss", "mississippi") do n +:= 1
+	write("count: ", n)
+
+	# upto
+	every writes(" ", upto("aeiou", "the quick brown fox"))
+	write()
+	every writes(" ", upto(%digits, "a1b22c333", 4))
+	write()
+
+	# match, many, any
+	write(match("abra", "abracadabra"), " ", match("cad", "abracadabra", 5),
+		" ", match("cad", "abracadabra") | "fail")
+	write(many(%letters, "hello, world"), " ", many(" ", "   x", 1, 3),
+		" ", many(%digits, "abc") | "fail")
+	write(any("aeiou", "echo"), " ", any("aeiou", "echo", 2) | "fail",
+		" ", any("x", "x", 2) | "fail")
+
+	# bal
+	every writes(" ", bal(",", , , "f(a,(b,c)),g[1,2],h"))
+	write()
+	every writes(" ", bal(",", "([", ")]", "f(a,(b,c)),g[1,2],h"))
+	write()
+	every writes(" ", bal(, , , "(a)b(c)"))
+	write()
+
+	# goal-directed splitting into words
+	local s := "  one two,three  four "
+	local i := 1
+	while i := upto(%letters, s, i) do {
+		local j := many(%letters, s, i)
+		writes("[", s[i:j], "]")
+		i := j
+	}
+	write()
+
+	# combinations with other expressions
+	write(find("n", "banana") = (3 | 5))
+	every write(i := find("an", "banana") & "banana"[i+:2])
+}
@@ -0,0 +1,77 @@
+#SRC: goaldi original
+#
+#	test serialize() and deserialize()
+
+record point(x, y)
+record node(value, next)
+
+procedure main() {
+	# scalar values
+	every local x := nil | 0 | -17 | 2.5 | -1/0.0 | 2^70 | rational(1, 3) |
+			decimal("1.50") | complex(1, -2) | "a\"b\nሴ𝄞" | "" |
+			cset("cab") | %letters | bytes("a\x00\xffb") | string | point do {
+		local y := deserialize(serialize(x))
+		write(image(y), " ", type(y), " ", image(x) == image(y))
+	}
+
+	# structures
+	local T := table(0)
+	T["a"] := [1, "x", []]
+	T[2] := set([3, "three"])
+	T[point(1, 2)] := nil
+	every local v := [] | [1, [2, [3]]] | set() | T | point(1, "y") |
+			tuple(a:1, b:[2]) do {
+		local s := image(v, 10)
+		local y := deserialize(serialize(v))
+		write(s, " ", type(y), " ", s == image(y, 10))
+	}
+	write(deserialize(serialize(T))["missing"])
+
+	# sharing and cycles
+	local L := [1, 2]
+	local M := [L, L, point(L, L)]
+	local N := deserialize(serialize(M))
+	write(N[1] === N[2] === N[3].x === N[3].y, " ", N[1] === L | "distinct")
+	local a := node(1)
+	local b := node(2, a)
+	a.next := b
+	local c := deserialize(serialize(a))
+	write(c.value, " ", c.next.value, " ", c.next.next === c)
+	local S := set()
+	S.put(S)
+	local S2 := deserialize(serialize(S))
+	write(*S2, " ", S2[S2] === S2)
+
+	# files
+	local f := file("serial1.tmp", "w")
+	serialize(M, f)
+	serialize("second", f)
+	f.close()
+	f := file("serial1.tmp")
+	write(image(deserialize(f), 3), " ", deserialize(f))
+	write(deserialize(f) | "end of file")
+	f.close()
+
+	# errors
+	try(lambda() serialize(main))
+	try(lambda() serialize([1, %stdout]))
+	try(lambda() serialize(channel()))
+	try(lambda() serialize(table(duration(5))))
+	try(lambda() deserialize(bytes("junk")))
+	try(lambda() deserialize(bytes("go")))
+	try(lambda() deserialize(bytes("goaldi\x09n")))
+	try(lambda() deserialize(bytes("goaldi\x01L\x03")))
+	try(lambda() deserialize(bytes("goaldi\x01nn")))
+	try(lambda() deserialize(bytes("goaldi\x01?")))
+	try(lambda() deserialize(bytes("goaldi\x01r\x52\x05point\x01\x01x")))
+	try(lambda() deserialize(bytes("goaldi\x01r\x52\x07nopoint\x00")))
+	try(lambda() deserialize("string"))
+	every local tag := "L" | "s" | "a" | "R\x01x" do	# forged huge counts
+		try(lambda() deserialize(bytes("goaldi\x01" || tag) ||
+			bytes([254, 255, 255, 255, 7])))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
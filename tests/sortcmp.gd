@@ -0,0 +1,63 @@
+#SRC: goaldi original
+#
+#	test sorting with comparators, key procedures, and descending order
+
+record emp(name, dept, salary)
+
+procedure main() {
+	local L := [5, 3, 8, 1, 9, 2]
+	write(image(L.sort()), " ", image(L.sort(, , 1)), " ", image(L))
+	write(image(L.sort(lambda(x, y) x > y)))
+	write(image(L.sort(key: lambda(x) x % 3)), " ",
+		image(L.sort(key: lambda(x) x % 3, desc: 1)))
+
+	local W := ["pear", "Fig", "apple", "kiwi", "Banana", "date"]
+	write(image(W.sort(key: lambda(s) map(s))))
+	write(image(W.sort(key: lambda(s) *s)))
+	write(image(W.sort([lambda(x, y) *x < *y, 1])))
+	write(image(W.sort(key: lambda(s) [*s, s], desc: 1)))
+	write(image(W.sort(key: lambda(s) (s[1] == "k") & s)))
+
+	# multi-key sorts of records
+	local E := [
+		emp("ann", "ops", 50), emp("bob", "dev", 70), emp("cal", "ops", 60),
+		emp("dee", "dev", 70), emp("eve", "dev", 55), emp("fay", "hr", 60)]
+	every writes(" ", (!E.sort([2, 3])).name)
+	write()
+	every writes(" ", (!E.sort([2, lambda(x, y) x.salary > y.salary])).name)
+	write()
+	every writes(" ", (!E.sort(3, , 1)).name)
+	write()
+	every writes(" ", (!E.sort(key: lambda(e) -e.salary)).name)
+	write()
+
+	# sets and tables
+	local S := set(W)
+	write(image(S.sort(key: lambda(s) map(s), desc: 1)))
+	local T := table()
+	every local w := !W do
+		T[w] := *w
+	every writes(" ", (!T.sort([2, 1], , 1)).key)
+	write()
+	every writes(" ", (!T.sort(lambda(x, y) x.value > y.value |
+		(x.value = y.value & x.key << y.key))).key)
+	write()
+	local M := sortmap()
+	every local v := !W do
+		M[v] := *v
+	every writes(" ", (!M.sort(2, , 1)).key)
+	write()
+
+	# exceptions in procedures propagate
+	try(lambda() L.sort(lambda(x, y) x < "z"))
+	try(lambda() L.sort(key: lambda(x) throw("bad key", x)))
+	try(lambda() L.sort([1, 0]))
+	try(lambda() L.sort([]))
+	try(lambda() L.sort(key: "x"))
+	write(image(L))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
@@ -0,0 +1,90 @@
+#SRC: goaldi original
+#
+#	test sorted maps
+
+procedure main() {
+	local M := sortmap(0)
+	every local k := ![30, 10, 50, 20, 40, 60, 5] do
+		M[k] := k / 10
+	M[20] +:= 100
+	write(*M, " ", image(M), " ", M[20], " ", M[25], " ", type(M), " ", M)
+	every writes(" ", (!M).key)
+	write()
+	every writes(" ", (M.ascend(15, 45)).key)
+	write()
+	every writes(" ", (M.descend(45, 15)).key)
+	write()
+	every writes(" ", (M.ascend(40)).key, "/", (M.descend(, 40)).key)
+	write()
+	write(M.floor(25).key, " ", M.ceiling(25).key, " ", M.floor(30).key, " ",
+		M.ceiling(30).key, " ", M.floor(4) | "none", " ", M.ceiling(61) | "none")
+	write(M.min().key, " ", M.max().key, " ", M.member(50), " ",
+		M.member(55) | "nonmember")
+	write(M.popmin().key, " ", M.popmax().key, " ", (@M).key, " ", image(M))
+	every writes(" ", (!M.sort(2)).value)
+	write()
+	M.delete(20, 99, 40)
+	write(image(M))
+
+	# generators tolerate changes between results
+	every local e := !M do {
+		writes(" ", e.key)
+		if e.key = 30 then M.delete(50) & (M[35] := 3.5)
+	}
+	write()
+
+	# keys of mixed types, ordered as by L.sort()
+	local S := sortmap()
+	every S[!["b", 2, "a", 1, nil, decimal("1.5"), [3], [1]]] := 1
+	write(image(S))
+	S[decimal(1)] := 2
+	write(*S, " ", S[1])
+
+	# distinct structures are distinct keys, even if they sort equally
+	local Q := sortmap()
+	local l12 := [1, 2]
+	local l13 := [1, 3]
+	local s1 := set()
+	local s2 := set()
+	Q[l12] := "l12"
+	Q[l13] := "l13"
+	Q[s2] := "s2"
+	Q[s1] := "s1"
+	Q[l12] := "L12"
+	write(*Q, " ", Q[l12], " ", Q[l13], " ", Q[s1], " ", Q[s2], " ",
+		image(Q[[1, 2]]), " ", Q.member(set()) | "nonmember")
+	every writes(" ", (!Q).value)
+	write(" ", Q.floor([1, 9]).value, " ", Q.ceiling([1]).value)
+	Q.delete(l12, s2, [1, 3])
+	write(*Q, " ", image(Q[l12]), " ", Q[l13], " ", Q[s1], " ", image(Q[s2]))
+
+	# NaN keys are rejected, because they compare with nothing
+	local nan := 1 / 0.0 - 1 / 0.0
+	every local p := (lambda() S[nan] := 3) | (lambda() S[nan]) |
+			(lambda() S.floor(nan)) | (lambda() S.delete(nan)) do
+		try(p)
+	write(*S)
+
+	# copies, deep copies, equality, and serialization
+	local C := M.copy()
+	C[1] := "one"
+	write(image(M), " ", image(C))
+	local T := sortmap()
+	T["x"] := M
+	T["y"] := M
+	local D := deepcopy(T)
+	D["x"][0] := "zero"
+	write(image(T["y"]), " ", image(D["y"], 2), " ", equal(T, deepcopy(T)),
+		" ", equal(T, D) | "unequal", " ", (hash(T) = hash(deepcopy(T)) & "samehash"))
+	local R := deserialize(serialize(T))
+	write(image(R, 3), " ", R["x"] === R["y"])
+
+	# draining with @
+	while writes(" ", (@C).key)
+	write(" ", *C, " ", image(C), " ", C.min() | "empty", " ", ?C | "empty")
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code)
+	write(image(p()))
+}
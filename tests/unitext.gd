@@ -0,0 +1,48 @@
+#SRC: goaldi original
+#
+#	test Unicode normalization, grapheme clusters, widths, and case folding
+
+procedure main() {
+	local e1 := "é"		# e + combining acute
+	local e2 := "é"		# precomposed é
+	write(*e1, " ", *e2, " ", e1 == e2 | "differ")
+	write(*normalize(e1), " ", *normalize(e2, "NFD"),
+		" ", normalize(e1) == e2, " ", normalize(e2, "nfd") == e1)
+	write(image(normalize("ﬁ½", "NFKC")), " ", image(normalize("ﬁ½", "NFC")),
+		" ", *normalize("ｶﾞ", "NFKC"), " ", image(normalize("한", "NFD")),
+		" ", normalize(normalize("한글", "NFD")) == "한글")
+	write(image(normalize("q̣̇")), " ",
+		image(normalize("ḍ̇", "NFC")))
+
+	# grapheme clusters
+	local s := "aé🇨🇦👩‍👩‍👦x\r\n"
+	write(*s, " ", ngraphemes(s), " ", ngraphemes(""))
+	every writes(" ", *graphemes(s))
+	write()
+	write(image(reverse("abc")), " ", image(reverse("née")),
+		" ", image(reverse("née", 1)))
+
+	# display widths
+	every local t := "abc" | "日本語" | e1 | "🇨🇦" | "👩‍👩‍👦" | "❤" | "❤️" |
+		"a\tb" | "" do
+		writes(" ", width(t))
+	write()
+	write("[", left("日本", 7, "-", 1), "] [", right("日本", 7, "-", 1), "] [",
+		center("日本", 7, "-", 1), "]")
+	write("[", left("日本語", 5, , 1), "] [", right("日本語", 5, , 1), "] [",
+		center("日本語x", 4, , 1), "]")
+	write("[", left(e1 || "x", 3, "+", 1), "] [", left(e1 || "x", 3, "+"), "]")
+	write("[", right("ab", 6, "日", 1), "] [", left("ab", 5, "日", 1), "]")
+
+	# case folding
+	write(casefold("Straße"), " ", casefold("STRASSE"), " ",
+		casefold("ΣΊΣΥΦΟΣ"), " ", casefold("ﬃ") == "ffi")
+	write(casefold("Straße") == casefold("strasse") | "differ")
+
+	try(lambda() normalize("x", "NFQ"))
+}
+
+procedure try(p) {
+	catch lambda(e) write("caught: ", e.msg, " ", e.code, " ", type(e))
+	write(p())
+}
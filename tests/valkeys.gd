@@ -0,0 +1,89 @@
+#SRC: goaldi original
+#
+#	test tables and sets keyed by value
+
+record point(x, y)
+
+procedure main() {
+	# ordinary tables use identity for structured keys
+	local T := table()
+	T[[1, 2]] := "a"
+	write(*T, " ", image(T[[1, 2]]), " ", T.member([1, 2]) | "nonmember")
+
+	# tables keyed by value
+	local V := table(0, byvalue:1)
+	V[[1, 2]] := "list"
+	V[point(3, 4)] := "point"
+	V[tuple(a:1)] := "tuple"
+	V[set([5, 6])] := "set"
+	V["s"] := "string"
+	write(*V, " ", V[[1, 2]], " ", V[point(3, 4)], " ", V[tuple(a:1)], " ",
+		V[set([6, 5])], " ", V["s"], " ", V[[2, 1]], " ", V[point(4, 3)])
+	V[[1, 2]] := "LIST"
+	write(*V, " ", V[[1, 2]], " ", image(V.member([1, 2])), " ",
+		V.member([9]) | "nonmember")
+	every local e := !V.sort() do
+		write("  ", image(e.key, 2), " ", e.value)
+	V.delete([1, 2], point(3, 4))
+	write(*V, " ", V[[1, 2]], " ", V[point(3, 4)])
+	local k := [7]
+	V[k] := "seven"
+	every local f := !V do
+		if f.value == "seven" then write(image(f.key), " ", (f.key === k) | "copied")
+	V[[7]] := "SEVEN"
+	write(*V, " ", V[k])
+	local C := V.copy()
+	C.delete([7])
+	write(*V, " ", *C, " ", C[[7]], " ", V[[7]])
+	while @C
+	write(*C, " ", C[tuple(a:1)])
+
+	# sets keyed by value
+	local S := set([[1], [1], point(1, 2), point(1, 2), "x"], byvalue:1)
+	write(*S, " ", S[[1]] | "nonmember", " ", S.member(point(1, 2)), " ",
+		S.member(point(2, 1)) | "nonmember")
+	S.put([1], [2])
+	S @: [2]
+	write(*S, " ", image(S, 3))
+	S.delete([1], "x")
+	write(*S, " ", image(S, 3), " ", image(S.sort(), 3))
+	local S2 := set([[2], [3]], byvalue:1)
+	write(image(S ++ S2, 3), " ", image(S ** S2, 3), " ", image(S -- S2, 3))
+	write(image(S ++ set([[2]]), 3))
+	write(image(set([[2], [3]]) ** S, 3))
+	every writes(" ", image(!set([[1], [1]], byvalue:1)))
+	write()
+
+	# mixed operands follow the left operand
+	local A := set([[1], [2]], byvalue:1)
+	local I := set([[1]])
+	write(*(A ** I), " ", *(I ** A), " ", *(A ++ I), " ", *(I ++ A), " ",
+		*(A -- I), " ", *(I -- A))
+	every I.put(!A)
+	write(*(A ** I), " ", *(I ** A), " ", *(I -- A))
+
+	# changing a value after adding it does not strand the entry
+	local m := [5]
+	local M := set([m], byvalue:1)
+	local W := table(, byvalue:1)
+	W[m] := "five"
+	m.put(6)
+	write(*M, " ", M[[5]] | "none", " ", M[m] | "none", " ", W[[5]], " ",
+		W[m] | "none")
+	M.put(m)
+	W[m] := "five-six"
+	write(*M, " ", image(M.sort(), 2), " ", *W)
+	M.delete([5])
+	W.delete([5])
+	write(*M, " ", image(M, 2), " ", *W, " ", W[[5, 6]])
+	while @S
+	write(*S)
+
+	# deepcopy and serialization preserve keying by value
+	local D := deepcopy(V)
+	D[[7]] := "copy"
+	write(*D, " ", D[[7]], " ", V[[7]])
+	local E := deserialize(serialize(set([[1], [1]], byvalue:1)))
+	E.put([1])
+	write(*E, " ", *deserialize(serialize(set([[1], [1]]))))
+}
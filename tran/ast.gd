@@ -30,6 +30,7 @@ record a_Record(ident, extendsRec, extendsPkg,idlist, coord, ir)
 record a_Repeat(body, expr, name, coord, ir)
 record a_Return(expr, coord, ir)
 record a_Catch(expr, coord, ir)
+record a_Defer(expr, coord, ir)
 record a_Fail(coord, ir)
 record a_Nil(coord, ir)
 record a_Suspend(expr, body, name, coord, ir)
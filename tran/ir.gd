@@ -10,6 +10,7 @@ record ir_chunk(label, insnList)
 
 record ir_NoOp(coord, comment)
 record ir_Catch(coord, lhs, fn)
+record ir_Defer(coord, lhs, fn)
 record ir_EnterScope(coord, nameList, dynamicList, scope, parentScope)
 record ir_ExitScope(coord, nameList, dynamicList, scope)
 
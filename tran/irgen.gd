@@ -966,6 +966,25 @@ procedure ir_a_Catch(p, st, target, bounded, rval) {
 		])
 }
 
+# record a_Defer( expr )
+procedure ir_a_Defer(p, st, target, bounded, rval) {
+	local t
+
+	ir_init(p)
+	t := ir_tmp(st)
+
+	suspend ir(p.expr, st, t, "always bounded", "rval")
+
+	suspend ir_chunk(p.ir.start,        [ ir_Goto(p.coord, p.expr.ir.start) ])
+	suspend ir_chunk(p.ir.resume,       [ ir_Goto(p.coord, p.ir.failure) ])
+
+	suspend ir_chunk(p.expr.ir.failure, [ ir_Goto(p.coord, p.ir.failure) ])
+	suspend ir_chunk(p.expr.ir.success, [
+		ir_Defer(p.coord, target, t),
+		ir_Goto(p.coord, p.ir.success),
+		])
+}
+
 # record a_Return( expr )
 procedure ir_a_Return(p, st, target, bounded, rval) {
 	local t
@@ -1749,6 +1768,7 @@ procedure ir(p, st, target, bounded, rval) {
 		a_Return : suspend ir_a_Return(p, st, target, bounded, rval)
 		a_Nil : suspend ir_a_Nil(p, st, target, bounded, rval)
 		a_Catch : suspend ir_a_Catch(p, st, target, bounded, rval)
+		a_Defer : suspend ir_a_Defer(p, st, target, bounded, rval)
 		a_Fail : suspend ir_a_Fail(p, st, target, bounded, rval)
 		a_Suspend : suspend ir_a_Suspend(p, st, target, bounded, rval)
 		a_While : suspend ir_a_While(p, st, target, bounded, rval)
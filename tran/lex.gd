@@ -255,6 +255,7 @@ global lex_CATCH         := lex_kwd("catch",     "b")
 global lex_CONTINUE      := lex_kwd("continue",  "be")
 global lex_CREATE        := lex_kwd("create",    "b")
 global lex_DEFAULT       := lex_kwd("default",   "b")
+global lex_DEFER         := lex_kwd("defer",     "b")
 global lex_DO            := lex_kwd("do",        "")
 global lex_ELSE          := lex_kwd("else",      "")
 global lex_EVERY         := lex_kwd("every",     "b")
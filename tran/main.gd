@@ -16,11 +16,12 @@ global optlist := [
 	optf("-E", "show initial environment"),
 	optf("-G", "compile to file.go (SECRET)"),
 	optf("-I", "trace initialization ordering"),
+	optf("-M", "write ./POSTMORTEM dump on fatal error"),
 	optf("-N", "inhibit optimization"),
 	optf("-P", "produce ./PROFILE file (Linux)"),
 	optf("-T", "trace IR instruction execution"),
 ]
-global gxopts := "ltADEIPT"	# options passed to goaldi interpreter
+global gxopts := "ltADEIMPT"	# options passed to goaldi interpreter
 
 
 #  main program -- see code above for usage 
@@ -160,6 +160,7 @@ procedure optim_def_use(p, uses, defs) {
 		ir_EnterScope :    { }
 		ir_ExitScope :    { }
 		ir_Catch :   { uses[p.fn] +:= 1 }
+		ir_Defer :   { uses[p.fn] +:= 1 }
 
 		ir_Unreachable:{ }
 
@@ -244,6 +245,7 @@ procedure optim_rewrite(p, rename) {
 		ir_EnterScope :    { }
 		ir_ExitScope :    { }
 		ir_Catch :   { p.fn := optim_rename(p.fn, rename) }
+		ir_Defer :   { p.fn := optim_rename(p.fn, rename) }
 
 		default :   { throw("unrecognized type", p) }
 	}
@@ -350,6 +352,7 @@ procedure optim_goto_transitive(t, new, lab) {
 			ir_EnterScope |
 			ir_ExitScope |
 			ir_Catch |
+			ir_Defer |
 			ir_Deref : {
 				# nothing
 			}
@@ -469,6 +472,7 @@ procedure optim_refcountX(refcount, p) {
 		ir_EnterScope :    { }
 		ir_ExitScope :    { }
 		ir_Catch :   { }
+		ir_Defer :   { }
 		default : throw("unrecognized type", p)
 	}
 }
@@ -210,6 +210,7 @@ procedure parser.parse_do_proc(noident) {
 		lex_LAMBDA,
 		lex_SELECT,
 		lex_CATCH,
+		lex_DEFER,
 		lex_LOCAL,
 		lex_STATIC,
 		lex_LCOMP,
@@ -313,6 +314,7 @@ procedure parser.parse_nexpr() {
 		lex_LAMBDA,
 		lex_SELECT,
 		lex_CATCH,
+		lex_DEFER,
 		lex_LOCAL,
 		lex_STATIC,
 		lex_LCOMP,
@@ -581,6 +583,7 @@ procedure parser.parse_expr10() {
 		lex_WITH,
 		lex_SELECT,
 		lex_CATCH,
+		lex_DEFER,
 		lex_LOCAL,
 		lex_CARET,
 		lex_STATIC,
@@ -653,6 +656,8 @@ procedure parser.parse_expr11() {
 			return self.parse_literal()
 		lex_CATCH   :
 			return self.parse_do_catch()
+		lex_DEFER   :
+			return self.parse_do_defer()
 		lex_NIL    : {
 			coord := self.cur_tok.coord
 			self.eat_token()
@@ -828,6 +833,7 @@ procedure parser.parse_expr11suffix(lhs) {
 		lex_WITH,
 		lex_SELECT,
 		lex_CATCH,
+		lex_DEFER,
 		lex_LOCAL,
 		lex_STATIC,
 		lex_LCOMP,
@@ -1078,6 +1084,7 @@ procedure parser.parse_do_lambda() {
 		lex_LAMBDA,
 		lex_SELECT,
 		lex_CATCH,
+		lex_DEFER,
 		lex_LOCAL,
 		lex_STATIC,
 		lex_LCOMP,
@@ -1207,6 +1214,21 @@ procedure parser.parse_do_catch() {
 	return a_Catch(e, coord)
 }
 
+procedure parser.parse_do_defer() {
+	local e
+	local coord
+	local endcoord
+	#  DEFER  expr
+	#  (expr becomes the body of a parameterless lambda)
+	coord := self.cur_tok.coord
+	self.match_token(lex_DEFER)
+	e := self.parse_expr()
+	endcoord := self.cur_tok.coord
+	return a_Defer(a_ProcDecl(a_Ident(nil, nil, coord), [], nil,
+		a_ProcCode(a_Compound([e], coord), endcoord),
+		coord, endcoord), coord)
+}
+
 procedure parser.parse_do_return() {
 	local e
 	local coord
@@ -1340,6 +1362,7 @@ procedure parser.parse_cclause() {
 		lex_WITH,
 		lex_SELECT,
 		lex_CATCH,
+		lex_DEFER,
 		lex_LOCAL,
 		lex_STATIC,
 		lex_LCOMP,